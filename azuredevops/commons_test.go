@@ -0,0 +1,123 @@
+package azuredevops
+
+// Test helpers that are shared by the acceptance tests of several resources and data sources. This file must not have
+// a build tag, so that the helpers are available whichever tags a test run uses.
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/google/uuid"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/terraform"
+	"github.com/microsoft/azure-devops-go-api/azuredevops/core"
+	"github.com/microsoft/azure-devops-go-api/azuredevops/policy"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/utils/config"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/utils/converter"
+)
+
+// Project and repository the branch and repository policies of the unit tests are scoped to
+var testPolicyProjectID = uuid.New().String()
+var testPolicyRepoID = uuid.New().String()
+
+// verifies that all projects referenced in the state are destroyed. This will be invoked
+// *after* terrafform destroys the resource but *before* the state is wiped clean.
+func testAccProjectCheckDestroy(s *terraform.State) error {
+	clients := testAccProvider.Meta().(*config.AggregatedClient)
+
+	// verify that every project referenced in the state does not exist in AzDO
+	for _, resource := range s.RootModule().Resources {
+		if resource.Type != "azuredevops_project" {
+			continue
+		}
+
+		id := resource.Primary.ID
+
+		// indicates the project still exists - this should fail the test
+		if _, err := projectRead(clients, id, ""); err == nil {
+			return fmt.Errorf("project with ID %s should not exist", id)
+		}
+	}
+
+	return nil
+}
+
+// verifies that all repositories referenced in the state are destroyed
+func testAccAzureGitRepoCheckDestroy(s *terraform.State) error {
+	clients := testAccProvider.Meta().(*config.AggregatedClient)
+
+	// verify that every repository referenced in the state does not exist in AzDO
+	for _, resource := range s.RootModule().Resources {
+		if resource.Type != "azuredevops_azure_git_repository" {
+			continue
+		}
+
+		repoID := resource.Primary.ID
+		projectID := resource.Primary.Attributes["project_id"]
+
+		// indicates the git repository still exists - this should fail the test
+		if _, err := azureGitRepositoryRead(clients, repoID, "", projectID); err == nil {
+			return fmt.Errorf("repository with ID %s should not exist", repoID)
+		}
+	}
+
+	return nil
+}
+
+func testAccBranchPolicyImportStateIDFunc(resourceName string) resource.ImportStateIdFunc {
+	return func(s *terraform.State) (string, error) {
+		res, ok := s.RootModule().Resources[resourceName]
+		if !ok {
+			return "", fmt.Errorf("Did not find %s in the TF state", resourceName)
+		}
+		return fmt.Sprintf("%s/%s", res.Primary.Attributes["project_id"], res.Primary.ID), nil
+	}
+}
+
+// verifies that all policies referenced in the state are destroyed. The project is destroyed together with its
+// policies, so a policy is also considered destroyed if the project cannot be found anymore.
+func testAccBranchPolicyCheckDestroy(s *terraform.State) error {
+	clients := testAccProvider.Meta().(*config.AggregatedClient)
+
+	for _, res := range s.RootModule().Resources {
+		if !strings.HasPrefix(res.Type, "azuredevops_branch_policy_") && !strings.HasPrefix(res.Type, "azuredevops_repository_policy_") {
+			continue
+		}
+
+		policyID, err := strconv.Atoi(res.Primary.ID)
+		if err != nil {
+			return fmt.Errorf("Error parsing policy configuration ID %s: %+v", res.Primary.ID, err)
+		}
+		policyConfig, err := clients.PolicyClient.GetPolicyConfiguration(clients.Ctx, policy.GetPolicyConfigurationArgs{
+			Project:         converter.String(res.Primary.Attributes["project_id"]),
+			ConfigurationId: &policyID,
+		})
+		if err == nil && !converter.ToBool(policyConfig.IsDeleted, false) {
+			return fmt.Errorf("Policy configuration %d has not been destroyed", policyID)
+		}
+	}
+	return nil
+}
+
+// verifies that the teams referenced in the state are destroyed.
+func testAccTeamCheckDestroy(s *terraform.State) error {
+	clients := testAccProvider.Meta().(*config.AggregatedClient)
+
+	for _, resource := range s.RootModule().Resources {
+		if resource.Type != "azuredevops_team" {
+			continue
+		}
+
+		// indicates the team still exists - this should fail the test
+		_, err := clients.CoreClient.GetTeam(clients.Ctx, core.GetTeamArgs{
+			ProjectId: converter.String(resource.Primary.Attributes["project_id"]),
+			TeamId:    converter.String(resource.Primary.ID),
+		})
+		if err == nil {
+			return fmt.Errorf("Team %s should not exist", resource.Primary.ID)
+		}
+	}
+
+	return nil
+}
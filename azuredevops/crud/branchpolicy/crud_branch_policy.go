@@ -0,0 +1,448 @@
+package crudbranchpolicy
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/google/uuid"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/validation"
+	"github.com/microsoft/azure-devops-go-api/azuredevops/policy"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/utils/config"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/utils/converter"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/utils/validate"
+)
+
+// Keys of the schema shared by all policies
+const (
+	SchemaProjectID     = "project_id"
+	SchemaEnabled       = "enabled"
+	SchemaBlocking      = "blocking"
+	SchemaSettings      = "settings"
+	schemaScope         = "scope"
+	schemaRepositoryID  = "repository_id"
+	schemaRepositoryRef = "repository_ref"
+	schemaMatchType     = "match_type"
+)
+
+// Match types of the branches a policy applies to
+const (
+	matchTypeExact         = "Exact"
+	matchTypePrefix        = "Prefix"
+	matchTypeDefaultBranch = "DefaultBranch"
+)
+
+// ExpandFunc converts the policy specific settings of the settings block into the settings of the policy configuration
+type ExpandFunc func(clients *config.AggregatedClient, tfSettings map[string]interface{}, policySettings map[string]interface{}) error
+
+// FlattenFunc converts the policy specific settings of the policy configuration into the settings block
+type FlattenFunc func(clients *config.AggregatedClient, policySettings map[string]interface{}, tfSettings map[string]interface{}) error
+
+// PolicyCrudArgs describes a policy type managed through GenBasePolicyResource. ExpandFunc and FlattenFunc can be
+// omitted for policies without settings besides their scope.
+type PolicyCrudArgs struct {
+	PolicyType uuid.UUID
+	// Set for policies that apply to whole repositories instead of branches
+	RepositoryScope bool
+	ExpandFunc      ExpandFunc
+	FlattenFunc     FlattenFunc
+}
+
+// GenBasePolicyResource creates a Resource with the common parts that all policies require: the project, the enabled
+// and blocking flags and a settings block with the scopes the policy applies to. Policy specific settings are added
+// to the settings block with SettingsSchema.
+func GenBasePolicyResource(crudArgs *PolicyCrudArgs) *schema.Resource {
+	return &schema.Resource{
+		Create: genPolicyCreateFunc(crudArgs),
+		Read:   genPolicyReadFunc(crudArgs),
+		Update: genPolicyUpdateFunc(crudArgs),
+		Delete: genPolicyDeleteFunc(),
+		Importer: &schema.ResourceImporter{
+			State: importPolicy,
+		},
+		Schema: genBaseSchema(crudArgs.RepositoryScope),
+	}
+}
+
+// SettingsSchema returns the schema of the settings block of a policy resource
+func SettingsSchema(r *schema.Resource) map[string]*schema.Schema {
+	return r.Schema[SchemaSettings].Elem.(*schema.Resource).Schema
+}
+
+func genBaseSchema(repositoryScope bool) map[string]*schema.Schema {
+	scopeSchema := map[string]*schema.Schema{
+		schemaRepositoryID: {
+			Type:         schema.TypeString,
+			Optional:     true,
+			ValidateFunc: validate.UUID,
+		},
+	}
+	if !repositoryScope {
+		scopeSchema[schemaRepositoryRef] = &schema.Schema{
+			Type:     schema.TypeString,
+			Optional: true,
+		}
+		scopeSchema[schemaMatchType] = &schema.Schema{
+			Type:     schema.TypeString,
+			Optional: true,
+			Default:  matchTypeExact,
+			ValidateFunc: validation.StringInSlice([]string{
+				matchTypeExact, matchTypePrefix, matchTypeDefaultBranch,
+			}, true),
+			DiffSuppressFunc: suppressCaseDiff,
+		}
+	}
+
+	return map[string]*schema.Schema{
+		SchemaProjectID: {
+			Type:         schema.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: validate.UUID,
+		},
+		SchemaEnabled: {
+			Type:     schema.TypeBool,
+			Optional: true,
+			Default:  true,
+		},
+		SchemaBlocking: {
+			Type:     schema.TypeBool,
+			Optional: true,
+			Default:  true,
+		},
+		SchemaSettings: {
+			Type:     schema.TypeList,
+			Required: true,
+			MinItems: 1,
+			MaxItems: 1,
+			Elem: &schema.Resource{
+				Schema: map[string]*schema.Schema{
+					schemaScope: {
+						Type:     schema.TypeList,
+						Required: true,
+						MinItems: 1,
+						Elem: &schema.Resource{
+							Schema: scopeSchema,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func suppressCaseDiff(k, old, new string, d *schema.ResourceData) bool {
+	return strings.EqualFold(old, new)
+}
+
+// Convert internal Terraform data structure to an AzDO data structure
+func expandPolicy(d *schema.ResourceData, clients *config.AggregatedClient, crudArgs *PolicyCrudArgs) (*policy.PolicyConfiguration, *string, error) {
+	projectID := converter.String(d.Get(SchemaProjectID).(string))
+	tfSettings := d.Get(SchemaSettings).([]interface{})[0].(map[string]interface{})
+
+	scopes, err := expandScopes(tfSettings[schemaScope].([]interface{}), crudArgs.RepositoryScope)
+	if err != nil {
+		return nil, nil, err
+	}
+	settings := map[string]interface{}{
+		"scope": scopes,
+	}
+	if crudArgs.ExpandFunc != nil {
+		if err := crudArgs.ExpandFunc(clients, tfSettings, settings); err != nil {
+			return nil, nil, err
+		}
+	}
+
+	policyConfig := &policy.PolicyConfiguration{
+		IsEnabled:  converter.Bool(d.Get(SchemaEnabled).(bool)),
+		IsBlocking: converter.Bool(d.Get(SchemaBlocking).(bool)),
+		Type: &policy.PolicyTypeRef{
+			Id: &crudArgs.PolicyType,
+		},
+		Settings: settings,
+	}
+	if d.Id() != "" {
+		policyID, err := strconv.Atoi(d.Id())
+		if err != nil {
+			return nil, nil, fmt.Errorf("Error parsing policy configuration ID %s: %+v", d.Id(), err)
+		}
+		policyConfig.Id = &policyID
+	}
+	return policyConfig, projectID, nil
+}
+
+func expandScopes(tfScopes []interface{}, repositoryScope bool) ([]map[string]interface{}, error) {
+	scopes := []map[string]interface{}{}
+	for _, tfScope := range tfScopes {
+		// empty scope blocks are read as nil
+		scopeValues, _ := tfScope.(map[string]interface{})
+		repositoryID, _ := scopeValues[schemaRepositoryID].(string)
+
+		// policies without a repository apply to all repositories of the project
+		scope := map[string]interface{}{
+			"repositoryId": nil,
+		}
+		if repositoryID != "" {
+			scope["repositoryId"] = repositoryID
+		}
+		if repositoryScope {
+			scopes = append(scopes, scope)
+			continue
+		}
+
+		repositoryRef, _ := scopeValues[schemaRepositoryRef].(string)
+		matchType, _ := scopeValues[schemaMatchType].(string)
+		if matchType == "" {
+			matchType = matchTypeExact
+		}
+
+		if strings.EqualFold(matchType, matchTypeDefaultBranch) {
+			if repositoryRef != "" {
+				return nil, fmt.Errorf("A repository_ref cannot be set for scopes with match_type %s", matchTypeDefaultBranch)
+			}
+			scope["matchKind"] = matchTypeDefaultBranch
+		} else {
+			if repositoryRef == "" {
+				return nil, fmt.Errorf("A repository_ref is required for scopes with match_type %s", matchType)
+			}
+			scope["refName"] = repositoryRef
+			scope["matchKind"] = matchType
+		}
+		scopes = append(scopes, scope)
+	}
+	return scopes, nil
+}
+
+// Convert AzDO data structure to internal Terraform data structure
+func flattenPolicy(d *schema.ResourceData, clients *config.AggregatedClient, policyConfig *policy.PolicyConfiguration, projectID *string, crudArgs *PolicyCrudArgs) error {
+	settings, ok := policyConfig.Settings.(map[string]interface{})
+	if !ok {
+		return fmt.Errorf("Unexpected settings of policy configuration %d", converter.ToInt(policyConfig.Id, 0))
+	}
+
+	tfSettings := map[string]interface{}{
+		schemaScope: flattenScopes(settings["scope"], crudArgs.RepositoryScope),
+	}
+	if crudArgs.FlattenFunc != nil {
+		if err := crudArgs.FlattenFunc(clients, settings, tfSettings); err != nil {
+			return err
+		}
+	}
+
+	d.SetId(strconv.Itoa(*policyConfig.Id))
+	d.Set(SchemaProjectID, converter.ToString(projectID, ""))
+	d.Set(SchemaEnabled, converter.ToBool(policyConfig.IsEnabled, false))
+	d.Set(SchemaBlocking, converter.ToBool(policyConfig.IsBlocking, false))
+	d.Set(SchemaSettings, []interface{}{tfSettings})
+	return nil
+}
+
+func flattenScopes(scopes interface{}, repositoryScope bool) []interface{} {
+	tfScopes := []interface{}{}
+	scopeList, ok := scopes.([]interface{})
+	if !ok {
+		return tfScopes
+	}
+	for _, scope := range scopeList {
+		scopeValues, ok := scope.(map[string]interface{})
+		if !ok {
+			continue
+		}
+		tfScope := map[string]interface{}{
+			schemaRepositoryID: GetString(scopeValues, "repositoryId"),
+		}
+		if !repositoryScope {
+			matchType := GetString(scopeValues, "matchKind")
+			if matchType == "" {
+				matchType = matchTypeExact
+			}
+			tfScope[schemaRepositoryRef] = GetString(scopeValues, "refName")
+			tfScope[schemaMatchType] = matchType
+		}
+		tfScopes = append(tfScopes, tfScope)
+	}
+	return tfScopes
+}
+
+func genPolicyCreateFunc(crudArgs *PolicyCrudArgs) schema.CreateFunc {
+	return func(d *schema.ResourceData, m interface{}) error {
+		clients := m.(*config.AggregatedClient)
+		policyConfig, projectID, err := expandPolicy(d, clients, crudArgs)
+		if err != nil {
+			return err
+		}
+
+		createdPolicy, err := clients.PolicyClient.CreatePolicyConfiguration(clients.Ctx, policy.CreatePolicyConfigurationArgs{
+			Configuration: policyConfig,
+			Project:       projectID,
+		})
+		if err != nil {
+			return fmt.Errorf("Error creating policy in Azure DevOps: %+v", err)
+		}
+
+		return flattenPolicy(d, clients, createdPolicy, projectID, crudArgs)
+	}
+}
+
+func genPolicyReadFunc(crudArgs *PolicyCrudArgs) schema.ReadFunc {
+	return func(d *schema.ResourceData, m interface{}) error {
+		clients := m.(*config.AggregatedClient)
+		projectID := converter.String(d.Get(SchemaProjectID).(string))
+		policyID, err := strconv.Atoi(d.Id())
+		if err != nil {
+			return fmt.Errorf("Error parsing policy configuration ID %s: %+v", d.Id(), err)
+		}
+
+		policyConfig, err := clients.PolicyClient.GetPolicyConfiguration(clients.Ctx, policy.GetPolicyConfigurationArgs{
+			Project:         projectID,
+			ConfigurationId: &policyID,
+		})
+		if err != nil {
+			return fmt.Errorf("Error looking up policy configuration with ID %d and project ID %s: %+v", policyID, *projectID, err)
+		}
+		if converter.ToBool(policyConfig.IsDeleted, false) {
+			d.SetId("")
+			return nil
+		}
+		if policyConfig.Type == nil || policyConfig.Type.Id == nil || *policyConfig.Type.Id != crudArgs.PolicyType {
+			return fmt.Errorf("Policy configuration %d is not of type %s", policyID, crudArgs.PolicyType.String())
+		}
+
+		return flattenPolicy(d, clients, policyConfig, projectID, crudArgs)
+	}
+}
+
+func genPolicyUpdateFunc(crudArgs *PolicyCrudArgs) schema.UpdateFunc {
+	return func(d *schema.ResourceData, m interface{}) error {
+		clients := m.(*config.AggregatedClient)
+		policyConfig, projectID, err := expandPolicy(d, clients, crudArgs)
+		if err != nil {
+			return err
+		}
+		if err := keepUnknownSettings(clients, policyConfig, projectID); err != nil {
+			return err
+		}
+
+		updatedPolicy, err := clients.PolicyClient.UpdatePolicyConfiguration(clients.Ctx, policy.UpdatePolicyConfigurationArgs{
+			Configuration:   policyConfig,
+			Project:         projectID,
+			ConfigurationId: policyConfig.Id,
+		})
+		if err != nil {
+			return fmt.Errorf("Error updating policy in Azure DevOps: %+v", err)
+		}
+
+		return flattenPolicy(d, clients, updatedPolicy, projectID, crudArgs)
+	}
+}
+
+// The settings of some policy types are shared with other resources, e.g. the Git repository settings policy also
+// holds whether forks are allowed. Settings of the existing policy configuration that are not managed by the
+// resource are therefore copied into the updated configuration instead of being dropped.
+func keepUnknownSettings(clients *config.AggregatedClient, policyConfig *policy.PolicyConfiguration, projectID *string) error {
+	existingPolicy, err := clients.PolicyClient.GetPolicyConfiguration(clients.Ctx, policy.GetPolicyConfigurationArgs{
+		Project:         projectID,
+		ConfigurationId: policyConfig.Id,
+	})
+	if err != nil {
+		return fmt.Errorf("Error looking up policy configuration with ID %d and project ID %s: %+v", *policyConfig.Id, *projectID, err)
+	}
+	if existingPolicy == nil {
+		return nil
+	}
+
+	existingSettings, _ := existingPolicy.Settings.(map[string]interface{})
+	MergeSettings(policyConfig.Settings.(map[string]interface{}), existingSettings)
+	return nil
+}
+
+// MergeSettings copies the settings of an existing policy configuration that are not set in the new settings, so
+// that resources sharing a policy configuration keep the settings of each other
+func MergeSettings(settings map[string]interface{}, existingSettings map[string]interface{}) {
+	for key, value := range existingSettings {
+		if _, ok := settings[key]; !ok {
+			settings[key] = value
+		}
+	}
+}
+
+func genPolicyDeleteFunc() schema.DeleteFunc {
+	return func(d *schema.ResourceData, m interface{}) error {
+		clients := m.(*config.AggregatedClient)
+		policyID, err := strconv.Atoi(d.Id())
+		if err != nil {
+			return fmt.Errorf("Error parsing policy configuration ID %s: %+v", d.Id(), err)
+		}
+
+		err = clients.PolicyClient.DeletePolicyConfiguration(clients.Ctx, policy.DeletePolicyConfigurationArgs{
+			Project:         converter.String(d.Get(SchemaProjectID).(string)),
+			ConfigurationId: &policyID,
+		})
+		if err != nil {
+			return fmt.Errorf("Error deleting policy in Azure DevOps: %+v", err)
+		}
+
+		d.SetId("")
+		return nil
+	}
+}
+
+// Import ID is of the form <project ID>/<policy configuration ID>, e.g. 782a8123-1019-xxxx-xxxx-xxxxxxxx/12
+func importPolicy(d *schema.ResourceData, m interface{}) ([]*schema.ResourceData, error) {
+	parts := strings.Split(d.Id(), "/")
+	if len(parts) != 2 || parts[0] == "" {
+		return nil, fmt.Errorf("Error parsing import ID %s. Expected format <project ID>/<policy configuration ID>", d.Id())
+	}
+	if _, err := strconv.Atoi(parts[1]); err != nil {
+		return nil, fmt.Errorf("Error parsing import ID %s. Policy configuration ID %s is not a number", d.Id(), parts[1])
+	}
+
+	d.Set(SchemaProjectID, parts[0])
+	d.SetId(parts[1])
+	return []*schema.ResourceData{d}, nil
+}
+
+// GetString reads a string setting of a policy configuration, or an empty string if it is not set
+func GetString(settings map[string]interface{}, key string) string {
+	if value, ok := settings[key].(string); ok {
+		return value
+	}
+	return ""
+}
+
+// GetBool reads a boolean setting of a policy configuration, or false if it is not set
+func GetBool(settings map[string]interface{}, key string) bool {
+	if value, ok := settings[key].(bool); ok {
+		return value
+	}
+	return false
+}
+
+// GetInt reads a numeric setting of a policy configuration, or 0 if it is not set. Settings read from the service
+// are decoded as float64.
+func GetInt(settings map[string]interface{}, key string) int {
+	switch value := settings[key].(type) {
+	case int:
+		return value
+	case float64:
+		return int(value)
+	}
+	return 0
+}
+
+// GetStrings reads a list setting of a policy configuration, or an empty list if it is not set
+func GetStrings(settings map[string]interface{}, key string) []string {
+	values := []string{}
+	switch list := settings[key].(type) {
+	case []string:
+		values = append(values, list...)
+	case []interface{}:
+		for _, value := range list {
+			if s, ok := value.(string); ok {
+				values = append(values, s)
+			}
+		}
+	}
+	return values
+}
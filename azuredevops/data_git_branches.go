@@ -0,0 +1,120 @@
+package azuredevops
+
+import (
+	"crypto/sha1"
+	"encoding/base64"
+	"fmt"
+	"log"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/microsoft/azure-devops-go-api/azuredevops/git"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/utils/config"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/utils/converter"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/utils/validate"
+)
+
+func dataGitBranches() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceGitBranchesRead,
+		Schema: map[string]*schema.Schema{
+			"repository_id": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validate.UUID,
+			},
+			"prefix": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validate.NoEmptyStrings,
+			},
+			"branches": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"name": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"commit_id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"locked": {
+							Type:     schema.TypeBool,
+							Computed: true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+// Reads the branches of a repository whose names start with the prefix. The prefix can be given with or without
+// the refs/heads/ prefix.
+func dataSourceGitBranchesRead(d *schema.ResourceData, m interface{}) error {
+	clients := m.(*config.AggregatedClient)
+	repoID := d.Get("repository_id").(string)
+	prefix := withBranchPrefix(d.Get("prefix").(string))
+
+	refs, err := getGitRefsWithPrefix(clients, repoID, prefix)
+	if err != nil {
+		return fmt.Errorf("Error reading branches of repository %s: %+v", repoID, err)
+	}
+	log.Printf("[TRACE] plugin.terraform-provider-azuredevops: Read [%d] branches", len(refs))
+
+	branchNames := make([]string, 0, len(refs))
+	for _, ref := range refs {
+		branchNames = append(branchNames, converter.ToString(ref.Name, ""))
+	}
+
+	h := sha1.New()
+	if _, err := h.Write([]byte(repoID + prefix + strings.Join(branchNames, "-"))); err != nil {
+		return fmt.Errorf("Unable to compute hash for branch names: %v", err)
+	}
+	d.SetId("branches#" + base64.URLEncoding.EncodeToString(h.Sum(nil)))
+	return d.Set("branches", flattenGitBranches(refs))
+}
+
+// Reads all refs of the repository whose names start with the prefix, following continuation tokens
+func getGitRefsWithPrefix(clients *config.AggregatedClient, repoID string, prefix string) ([]git.GitRef, error) {
+	var refs []git.GitRef
+	var currentToken string
+
+	for hasMore := true; hasMore; {
+		args := git.GetRefsArgs{
+			RepositoryId: converter.String(repoID),
+			Filter:       converter.String(strings.TrimPrefix(prefix, "refs/")),
+		}
+		if currentToken != "" {
+			args.ContinuationToken = converter.String(currentToken)
+		}
+
+		response, err := clients.GitReposClient.GetRefs(clients.Ctx, args)
+		if err != nil {
+			return nil, err
+		}
+		if response == nil {
+			return nil, fmt.Errorf("No refs returned for filter %s", prefix)
+		}
+
+		refs = append(refs, response.Value...)
+		currentToken = response.ContinuationToken
+		hasMore = currentToken != ""
+	}
+	return refs, nil
+}
+
+func flattenGitBranches(refs []git.GitRef) []interface{} {
+	results := make([]interface{}, 0, len(refs))
+	for _, ref := range refs {
+		results = append(results, map[string]interface{}{
+			"name":      converter.ToString(ref.Name, ""),
+			"commit_id": converter.ToString(ref.ObjectId, ""),
+			"locked":    converter.ToBool(ref.IsLocked, false),
+		})
+	}
+	return results
+}
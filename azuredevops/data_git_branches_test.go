@@ -0,0 +1,154 @@
+// +build all core data_git_branches
+
+package azuredevops
+
+// The tests in this file use the mock clients in mock_client.go to mock out
+// the Azure DevOps client operations.
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/google/uuid"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/microsoft/azure-devops-go-api/azuredevops/git"
+	"github.com/microsoft/terraform-provider-azuredevops/azdosdkmocks"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/utils/config"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/utils/converter"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/utils/testhelper"
+	"github.com/stretchr/testify/require"
+)
+
+/**
+ * Begin unit tests
+ */
+
+// verifies that the prefix is applied to the branch names and that all pages of refs are read
+func TestDataSourceGitBranches_Read_FollowsContinuationToken(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	reposClient := azdosdkmocks.NewMockGitClient(ctrl)
+	clients := &config.AggregatedClient{GitReposClient: reposClient, Ctx: context.Background()}
+
+	repoID := uuid.New().String()
+	resourceData := schema.TestResourceDataRaw(t, dataGitBranches().Schema, nil)
+	resourceData.Set("repository_id", repoID)
+	resourceData.Set("prefix", "release/")
+
+	gomock.InOrder(
+		reposClient.
+			EXPECT().
+			GetRefs(clients.Ctx, git.GetRefsArgs{
+				RepositoryId: converter.String(repoID),
+				Filter:       converter.String("heads/release/"),
+			}).
+			Return(&git.GetRefsResponseValue{
+				Value:             []git.GitRef{{Name: converter.String("refs/heads/release/1.0"), ObjectId: converter.String("1")}},
+				ContinuationToken: "next",
+			}, nil),
+		reposClient.
+			EXPECT().
+			GetRefs(clients.Ctx, git.GetRefsArgs{
+				RepositoryId:      converter.String(repoID),
+				Filter:            converter.String("heads/release/"),
+				ContinuationToken: converter.String("next"),
+			}).
+			Return(&git.GetRefsResponseValue{
+				Value: []git.GitRef{{Name: converter.String("refs/heads/release/2.0"), ObjectId: converter.String("2"), IsLocked: converter.Bool(true)}},
+			}, nil),
+	)
+
+	err := dataSourceGitBranchesRead(resourceData, clients)
+	require.Nil(t, err)
+	require.NotEmpty(t, resourceData.Id())
+	require.Equal(t, 2, resourceData.Get("branches.#"))
+	require.Equal(t, "refs/heads/release/1.0", resourceData.Get("branches.0.name"))
+	require.Equal(t, "1", resourceData.Get("branches.0.commit_id"))
+	require.Equal(t, "refs/heads/release/2.0", resourceData.Get("branches.1.name"))
+	require.Equal(t, true, resourceData.Get("branches.1.locked"))
+}
+
+// verifies that all branches are requested if no prefix is configured, and that errors are not swallowed
+func TestDataSourceGitBranches_Read_DoesNotSwallowError(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	reposClient := azdosdkmocks.NewMockGitClient(ctrl)
+	clients := &config.AggregatedClient{GitReposClient: reposClient, Ctx: context.Background()}
+
+	repoID := uuid.New().String()
+	resourceData := schema.TestResourceDataRaw(t, dataGitBranches().Schema, nil)
+	resourceData.Set("repository_id", repoID)
+
+	reposClient.
+		EXPECT().
+		GetRefs(clients.Ctx, git.GetRefsArgs{
+			RepositoryId: converter.String(repoID),
+			Filter:       converter.String("heads/"),
+		}).
+		Return(nil, errors.New("GetRefs() Failed")).
+		Times(1)
+
+	err := dataSourceGitBranchesRead(resourceData, clients)
+	require.NotNil(t, err)
+	require.Contains(t, err.Error(), "GetRefs() Failed")
+}
+
+// verifies that an empty response of the service is reported instead of dereferenced
+func TestDataSourceGitBranches_Read_ErrorsOnNilResponse(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	reposClient := azdosdkmocks.NewMockGitClient(ctrl)
+	clients := &config.AggregatedClient{GitReposClient: reposClient, Ctx: context.Background()}
+
+	repoID := uuid.New().String()
+	resourceData := schema.TestResourceDataRaw(t, dataGitBranches().Schema, nil)
+	resourceData.Set("repository_id", repoID)
+
+	reposClient.
+		EXPECT().
+		GetRefs(clients.Ctx, gomock.Any()).
+		Return(nil, nil).
+		Times(1)
+
+	err := dataSourceGitBranchesRead(resourceData, clients)
+	require.NotNil(t, err)
+	require.Contains(t, err.Error(), "No refs returned")
+}
+
+/**
+ * Begin acceptance tests
+ */
+
+// Verifies that the branches of a repository can be filtered by prefix
+func TestAccGitBranchesDataSource_Read_FiltersByPrefix(t *testing.T) {
+	projectName := testhelper.TestAccResourcePrefix + acctest.RandStringFromCharSet(10, acctest.CharSetAlphaNum)
+	gitRepoName := testhelper.TestAccResourcePrefix + acctest.RandStringFromCharSet(10, acctest.CharSetAlphaNum)
+	tfDataNode := "data.azuredevops_git_branches.branches"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testhelper.TestAccPreCheck(t, nil) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccAzureGitRepoCheckDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testhelper.TestAccGitBranchesAndFileDataSources(projectName, gitRepoName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(tfDataNode, "branches.#", "1"),
+					resource.TestCheckResourceAttr(tfDataNode, "branches.0.name", "refs/heads/release/1.0"),
+					resource.TestCheckResourceAttrPair(tfDataNode, "branches.0.commit_id", "azuredevops_git_branch.branch", "last_commit_id"),
+				),
+			},
+		},
+	})
+}
+
+func init() {
+	InitProvider()
+}
@@ -0,0 +1,153 @@
+package azuredevops
+
+import (
+	"crypto/sha1"
+	"encoding/base64"
+	"fmt"
+	"log"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/microsoft/azure-devops-go-api/azuredevops/git"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/utils/config"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/utils/converter"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/utils/validate"
+)
+
+func dataGitRepositories() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceGitRepositoriesRead,
+		Schema: map[string]*schema.Schema{
+			"project_id": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validate.UUID,
+			},
+			"name": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validate.NoEmptyStrings,
+			},
+			"include_hidden": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+			"repositories": {
+				Type:     schema.TypeSet,
+				Computed: true,
+				Set:      getGitRepositoryHash,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"name": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"project_id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"default_branch": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"is_fork": {
+							Type:     schema.TypeBool,
+							Computed: true,
+						},
+						"remote_url": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"size": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+						"ssh_url": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"url": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"web_url": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func getGitRepositoryHash(v interface{}) int {
+	return schema.HashString(v.(map[string]interface{})["id"].(string))
+}
+
+// Reads the repositories of a project, or of all projects in the organization if no project is specified. Disabled
+// repositories are always returned by the service; hidden repositories only on request.
+func dataSourceGitRepositoriesRead(d *schema.ResourceData, m interface{}) error {
+	clients := m.(*config.AggregatedClient)
+	projectID := d.Get("project_id").(string)
+	name := d.Get("name").(string)
+
+	repos, err := clients.GitReposClient.GetRepositories(clients.Ctx, git.GetRepositoriesArgs{
+		Project:       converter.String(projectID),
+		IncludeHidden: converter.Bool(d.Get("include_hidden").(bool)),
+	})
+	if err != nil {
+		return fmt.Errorf("Error reading repositories: %+v", err)
+	}
+
+	var matchingRepos []git.GitRepository
+	if repos != nil {
+		for _, repo := range *repos {
+			if name == "" || strings.EqualFold(converter.ToString(repo.Name, ""), name) {
+				matchingRepos = append(matchingRepos, repo)
+			}
+		}
+	}
+	log.Printf("[TRACE] plugin.terraform-provider-azuredevops: Read [%d] repositories", len(matchingRepos))
+
+	repoIDs := make([]string, 0, len(matchingRepos))
+	for _, repo := range matchingRepos {
+		repoIDs = append(repoIDs, repo.Id.String())
+	}
+
+	h := sha1.New()
+	if _, err := h.Write([]byte(projectID + name + strings.Join(repoIDs, "-"))); err != nil {
+		return fmt.Errorf("Unable to compute hash for repository IDs: %v", err)
+	}
+	d.SetId("repositories#" + base64.URLEncoding.EncodeToString(h.Sum(nil)))
+	return d.Set("repositories", flattenGitRepositories(matchingRepos))
+}
+
+func flattenGitRepositories(repos []git.GitRepository) []interface{} {
+	results := make([]interface{}, 0, len(repos))
+	for _, repo := range repos {
+		output := map[string]interface{}{
+			"id":             repo.Id.String(),
+			"name":           converter.ToString(repo.Name, ""),
+			"default_branch": converter.ToString(repo.DefaultBranch, ""),
+			"is_fork":        converter.ToBool(repo.IsFork, false),
+			"remote_url":     converter.ToString(repo.RemoteUrl, ""),
+			"ssh_url":        converter.ToString(repo.SshUrl, ""),
+			"url":            converter.ToString(repo.Url, ""),
+			"web_url":        converter.ToString(repo.WebUrl, ""),
+		}
+		if repo.Project != nil && repo.Project.Id != nil {
+			output["project_id"] = repo.Project.Id.String()
+		}
+		if repo.Size != nil {
+			output["size"] = int(*repo.Size)
+		}
+		results = append(results, output)
+	}
+	return results
+}
@@ -0,0 +1,137 @@
+// +build all core data_git_repositories
+
+package azuredevops
+
+// The tests in this file use the mock clients in mock_client.go to mock out
+// the Azure DevOps client operations.
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/google/uuid"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/microsoft/azure-devops-go-api/azuredevops/core"
+	"github.com/microsoft/azure-devops-go-api/azuredevops/git"
+	"github.com/microsoft/terraform-provider-azuredevops/azdosdkmocks"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/utils/config"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/utils/converter"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/utils/testhelper"
+	"github.com/stretchr/testify/require"
+)
+
+/**
+ * Begin unit tests
+ */
+
+// verifies that repositories are filtered by name and that hidden repositories are requested if configured
+func TestDataSourceGitRepositories_Read_FiltersByName(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	reposClient := azdosdkmocks.NewMockGitClient(ctrl)
+	clients := &config.AggregatedClient{GitReposClient: reposClient, Ctx: context.Background()}
+
+	projectID := uuid.New()
+	repoID := uuid.New()
+	otherRepoID := uuid.New()
+	size := uint64(1024)
+	repos := []git.GitRepository{
+		{
+			Id:            &repoID,
+			Name:          converter.String("Service"),
+			Project:       &core.TeamProjectReference{Id: &projectID},
+			DefaultBranch: converter.String("refs/heads/master"),
+			IsFork:        converter.Bool(true),
+			Size:          &size,
+			RemoteUrl:     converter.String("https://dev.azure.com/org/project/_git/Service"),
+		},
+		{
+			Id:   &otherRepoID,
+			Name: converter.String("Website"),
+		},
+	}
+
+	resourceData := schema.TestResourceDataRaw(t, dataGitRepositories().Schema, nil)
+	resourceData.Set("project_id", projectID.String())
+	resourceData.Set("name", "service")
+	resourceData.Set("include_hidden", true)
+
+	reposClient.
+		EXPECT().
+		GetRepositories(clients.Ctx, git.GetRepositoriesArgs{
+			Project:       converter.String(projectID.String()),
+			IncludeHidden: converter.Bool(true),
+		}).
+		Return(&repos, nil).
+		Times(1)
+
+	err := dataSourceGitRepositoriesRead(resourceData, clients)
+	require.Nil(t, err)
+
+	results := resourceData.Get("repositories").(*schema.Set).List()
+	require.Len(t, results, 1)
+	repo := results[0].(map[string]interface{})
+	require.Equal(t, repoID.String(), repo["id"])
+	require.Equal(t, "Service", repo["name"])
+	require.Equal(t, projectID.String(), repo["project_id"])
+	require.Equal(t, "refs/heads/master", repo["default_branch"])
+	require.Equal(t, true, repo["is_fork"])
+	require.Equal(t, 1024, repo["size"])
+}
+
+// verifies that the repositories of all projects are requested if no project is configured, and that errors are
+// not swallowed
+func TestDataSourceGitRepositories_Read_DoesNotSwallowError(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	reposClient := azdosdkmocks.NewMockGitClient(ctrl)
+	clients := &config.AggregatedClient{GitReposClient: reposClient, Ctx: context.Background()}
+
+	resourceData := schema.TestResourceDataRaw(t, dataGitRepositories().Schema, nil)
+
+	reposClient.
+		EXPECT().
+		GetRepositories(clients.Ctx, git.GetRepositoriesArgs{
+			IncludeHidden: converter.Bool(false),
+		}).
+		Return(nil, errors.New("GetRepositories() Failed")).
+		Times(1)
+
+	err := dataSourceGitRepositoriesRead(resourceData, clients)
+	require.Contains(t, err.Error(), "GetRepositories() Failed")
+}
+
+/**
+ * Begin acceptance tests
+ */
+
+// Verifies that a repository can be looked up by its name
+func TestAccGitRepositoriesDataSource_Read_FiltersByName(t *testing.T) {
+	projectName := testhelper.TestAccResourcePrefix + acctest.RandStringFromCharSet(10, acctest.CharSetAlphaNum)
+	gitRepoName := testhelper.TestAccResourcePrefix + acctest.RandStringFromCharSet(10, acctest.CharSetAlphaNum)
+	tfDataNode := "data.azuredevops_git_repositories.repositories"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testhelper.TestAccPreCheck(t, nil) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccAzureGitRepoCheckDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testhelper.TestAccGitRepositoriesDataSource(projectName, gitRepoName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(tfDataNode, "repositories.#", "1"),
+				),
+			},
+		},
+	})
+}
+
+func init() {
+	InitProvider()
+}
@@ -0,0 +1,91 @@
+package azuredevops
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/microsoft/azure-devops-go-api/azuredevops/git"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/utils/config"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/utils/converter"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/utils/validate"
+)
+
+func dataGitRepositoryFile() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceGitRepositoryFileRead,
+		Schema: map[string]*schema.Schema{
+			"repository_id": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validate.UUID,
+			},
+			"file": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validate.NoEmptyStrings,
+			},
+			"branch": {
+				Type:          schema.TypeString,
+				Optional:      true,
+				ValidateFunc:  validate.NoEmptyStrings,
+				ConflictsWith: []string{"tag"},
+			},
+			"tag": {
+				Type:          schema.TypeString,
+				Optional:      true,
+				ValidateFunc:  validate.NoEmptyStrings,
+				ConflictsWith: []string{"branch"},
+			},
+			"content": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"commit_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+// Reads the content of a file on a branch or tag. If neither is given, the file is read from the default branch
+// of the repository.
+func dataSourceGitRepositoryFileRead(d *schema.ResourceData, m interface{}) error {
+	clients := m.(*config.AggregatedClient)
+	repoID := d.Get("repository_id").(string)
+	file := d.Get("file").(string)
+
+	args := git.GetItemArgs{
+		RepositoryId:   converter.String(repoID),
+		Path:           converter.String(file),
+		IncludeContent: converter.Bool(true),
+	}
+	version := "default branch"
+	if branch, ok := d.GetOk("branch"); ok {
+		version = withBranchPrefix(branch.(string))
+		args.VersionDescriptor = &git.GitVersionDescriptor{
+			Version:     converter.String(strings.TrimPrefix(version, "refs/heads/")),
+			VersionType: &git.GitVersionTypeValues.Branch,
+		}
+	} else if tag, ok := d.GetOk("tag"); ok {
+		version = withTagPrefix(tag.(string))
+		args.VersionDescriptor = &git.GitVersionDescriptor{
+			Version:     converter.String(strings.TrimPrefix(version, "refs/tags/")),
+			VersionType: &git.GitVersionTypeValues.Tag,
+		}
+	}
+
+	item, err := clients.GitReposClient.GetItem(clients.Ctx, args)
+	if err != nil {
+		if isGitItemNotFoundError(err) {
+			return fmt.Errorf("File %s does not exist on %s of repository %s", file, version, repoID)
+		}
+		return fmt.Errorf("Error reading file %s on %s: %+v", file, version, err)
+	}
+
+	d.SetId(fmt.Sprintf("%s:%s:%s", repoID, version, file))
+	d.Set("content", converter.ToString(item.Content, ""))
+	d.Set("commit_id", converter.ToString(item.CommitId, ""))
+	return nil
+}
@@ -0,0 +1,122 @@
+// +build all core data_git_repository_file
+
+package azuredevops
+
+// The tests in this file use the mock clients in mock_client.go to mock out
+// the Azure DevOps client operations.
+
+import (
+	"context"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/google/uuid"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/microsoft/azure-devops-go-api/azuredevops"
+	"github.com/microsoft/azure-devops-go-api/azuredevops/git"
+	"github.com/microsoft/terraform-provider-azuredevops/azdosdkmocks"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/utils/config"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/utils/converter"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/utils/testhelper"
+	"github.com/stretchr/testify/require"
+)
+
+/**
+ * Begin unit tests
+ */
+
+// verifies that a file is read at the configured tag
+func TestDataSourceGitRepositoryFile_Read_UsesTagVersion(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	reposClient := azdosdkmocks.NewMockGitClient(ctrl)
+	clients := &config.AggregatedClient{GitReposClient: reposClient, Ctx: context.Background()}
+
+	repoID := uuid.New().String()
+	resourceData := schema.TestResourceDataRaw(t, dataGitRepositoryFile().Schema, nil)
+	resourceData.Set("repository_id", repoID)
+	resourceData.Set("file", "/VERSION")
+	resourceData.Set("tag", "refs/tags/v1.0")
+
+	reposClient.
+		EXPECT().
+		GetItem(clients.Ctx, git.GetItemArgs{
+			RepositoryId:   converter.String(repoID),
+			Path:           converter.String("/VERSION"),
+			IncludeContent: converter.Bool(true),
+			VersionDescriptor: &git.GitVersionDescriptor{
+				Version:     converter.String("v1.0"),
+				VersionType: &git.GitVersionTypeValues.Tag,
+			},
+		}).
+		Return(&git.GitItem{Content: converter.String("1.0.0"), CommitId: converter.String("1")}, nil).
+		Times(1)
+
+	err := dataSourceGitRepositoryFileRead(resourceData, clients)
+	require.Nil(t, err)
+	require.Equal(t, repoID+":refs/tags/v1.0:/VERSION", resourceData.Id())
+	require.Equal(t, "1.0.0", resourceData.Get("content"))
+	require.Equal(t, "1", resourceData.Get("commit_id"))
+}
+
+// verifies that a missing file is reported instead of being read as an empty file
+func TestDataSourceGitRepositoryFile_Read_ErrorsOnMissingFile(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	reposClient := azdosdkmocks.NewMockGitClient(ctrl)
+	clients := &config.AggregatedClient{GitReposClient: reposClient, Ctx: context.Background()}
+
+	repoID := uuid.New().String()
+	resourceData := schema.TestResourceDataRaw(t, dataGitRepositoryFile().Schema, nil)
+	resourceData.Set("repository_id", repoID)
+	resourceData.Set("file", "/VERSION")
+
+	reposClient.
+		EXPECT().
+		GetItem(clients.Ctx, git.GetItemArgs{
+			RepositoryId:   converter.String(repoID),
+			Path:           converter.String("/VERSION"),
+			IncludeContent: converter.Bool(true),
+		}).
+		Return(nil, azuredevops.WrappedError{TypeKey: converter.String("GitItemNotFoundException")}).
+		Times(1)
+
+	err := dataSourceGitRepositoryFileRead(resourceData, clients)
+	require.NotNil(t, err)
+	require.Contains(t, err.Error(), "does not exist on default branch")
+	require.Equal(t, "", resourceData.Id())
+}
+
+/**
+ * Begin acceptance tests
+ */
+
+// Verifies that the content of a file can be read from a branch
+func TestAccGitRepositoryFileDataSource_Read(t *testing.T) {
+	projectName := testhelper.TestAccResourcePrefix + acctest.RandStringFromCharSet(10, acctest.CharSetAlphaNum)
+	gitRepoName := testhelper.TestAccResourcePrefix + acctest.RandStringFromCharSet(10, acctest.CharSetAlphaNum)
+	tfDataNode := "data.azuredevops_git_repository_file.file"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testhelper.TestAccPreCheck(t, nil) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccAzureGitRepoCheckDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testhelper.TestAccGitBranchesAndFileDataSources(projectName, gitRepoName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(tfDataNode, "content", "1.0.0"),
+					resource.TestCheckResourceAttrPair(tfDataNode, "commit_id", "azuredevops_git_repository_file.file", "commit_id"),
+				),
+			},
+		},
+	})
+}
+
+func init() {
+	InitProvider()
+}
@@ -0,0 +1,62 @@
+package azuredevops
+
+import (
+	"fmt"
+
+	"github.com/google/uuid"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/utils/config"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/utils/validate"
+)
+
+func dataProjectProperties() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceProjectPropertiesRead,
+		Schema: map[string]*schema.Schema{
+			"project_id": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validate.UUID,
+			},
+			"keys": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Elem: &schema.Schema{
+					Type:         schema.TypeString,
+					ValidateFunc: validate.NoEmptyStrings,
+				},
+			},
+			"properties": {
+				Type:     schema.TypeMap,
+				Computed: true,
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
+		},
+	}
+}
+
+// Reads the properties of a project. If keys are specified (wildcards are supported) only the
+// matching properties are returned, otherwise all properties of the project are returned.
+func dataSourceProjectPropertiesRead(d *schema.ResourceData, m interface{}) error {
+	clients := m.(*config.AggregatedClient)
+	projectID, err := uuid.Parse(d.Get("project_id").(string))
+	if err != nil {
+		return fmt.Errorf("Invalid project UUID: %s", d.Get("project_id").(string))
+	}
+
+	var keys []string
+	for _, key := range d.Get("keys").([]interface{}) {
+		keys = append(keys, key.(string))
+	}
+
+	properties, err := readProjectProperties(clients, &projectID, keys)
+	if err != nil {
+		return fmt.Errorf("Error reading properties of project %s: %+v", projectID.String(), err)
+	}
+
+	d.SetId(projectID.String())
+	d.Set("properties", flattenProjectProperties(properties))
+	return nil
+}
@@ -0,0 +1,132 @@
+package azuredevops
+
+import (
+	"crypto/sha1"
+	"encoding/base64"
+	"fmt"
+	"log"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/microsoft/azure-devops-go-api/azuredevops/core"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/utils/config"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/utils/converter"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/utils/validate"
+)
+
+// Number of teams requested per page when listing teams
+const teamsPageSize = 100
+
+func dataTeams() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceTeamsRead,
+		Schema: map[string]*schema.Schema{
+			"project_id": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validate.UUID,
+			},
+			"teams": {
+				Type:     schema.TypeSet,
+				Computed: true,
+				Set:      getTeamHash,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"project_id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"name": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"description": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func getTeamHash(v interface{}) int {
+	return schema.HashString(v.(map[string]interface{})["id"].(string))
+}
+
+// Reads all teams of a project. If no project is specified, the teams of all projects in the organization are read.
+func dataSourceTeamsRead(d *schema.ResourceData, m interface{}) error {
+	clients := m.(*config.AggregatedClient)
+	projectID := d.Get("project_id").(string)
+
+	teams, err := getTeams(clients, projectID)
+	if err != nil {
+		return fmt.Errorf("Error reading teams: %+v", err)
+	}
+	log.Printf("[TRACE] plugin.terraform-provider-azuredevops: Read [%d] teams", len(teams))
+
+	results := flattenTeams(teams)
+	teamIDs := make([]string, 0, len(teams))
+	for _, team := range teams {
+		teamIDs = append(teamIDs, team.Id.String())
+	}
+
+	h := sha1.New()
+	if _, err := h.Write([]byte(projectID + strings.Join(teamIDs, "-"))); err != nil {
+		return fmt.Errorf("Unable to compute hash for team IDs: %v", err)
+	}
+	d.SetId("teams#" + base64.URLEncoding.EncodeToString(h.Sum(nil)))
+	return d.Set("teams", results)
+}
+
+// Reads the teams page by page, as the service returns only a limited number of teams per request
+func getTeams(clients *config.AggregatedClient, projectID string) ([]core.WebApiTeam, error) {
+	var teams []core.WebApiTeam
+	for skip := 0; ; skip += teamsPageSize {
+		var page *[]core.WebApiTeam
+		var err error
+		if projectID == "" {
+			page, err = clients.CoreClient.GetAllTeams(clients.Ctx, core.GetAllTeamsArgs{
+				Top:  converter.Int(teamsPageSize),
+				Skip: converter.Int(skip),
+			})
+		} else {
+			page, err = clients.CoreClient.GetTeams(clients.Ctx, core.GetTeamsArgs{
+				ProjectId: converter.String(projectID),
+				Top:       converter.Int(teamsPageSize),
+				Skip:      converter.Int(skip),
+			})
+		}
+		if err != nil {
+			return nil, err
+		}
+		if page == nil {
+			return teams, nil
+		}
+		teams = append(teams, *page...)
+		if len(*page) < teamsPageSize {
+			return teams, nil
+		}
+	}
+}
+
+func flattenTeams(teams []core.WebApiTeam) []interface{} {
+	results := make([]interface{}, 0, len(teams))
+	for _, team := range teams {
+		output := map[string]interface{}{
+			"id":          team.Id.String(),
+			"name":        converter.ToString(team.Name, ""),
+			"description": converter.ToString(team.Description, ""),
+		}
+		if team.ProjectId != nil {
+			output["project_id"] = team.ProjectId.String()
+		}
+		results = append(results, output)
+	}
+	return results
+}
@@ -9,20 +9,59 @@ import (
 func Provider() *schema.Provider {
 	p := &schema.Provider{
 		ResourcesMap: map[string]*schema.Resource{
-			"azuredevops_build_definition":          resourceBuildDefinition(),
-			"azuredevops_project":                   resourceProject(),
-			"azuredevops_variable_group":            resourceVariableGroup(),
-			"azuredevops_serviceendpoint_github":    resourceServiceEndpointGitHub(),
-			"azuredevops_serviceendpoint_dockerhub": resourceServiceEndpointDockerHub(),
-			"azuredevops_azure_git_repository":      resourceAzureGitRepository(),
-			"azuredevops_user_entitlement":          resourceUserEntitlement(),
-			"azuredevops_group_membership":          resourceGroupMembership(),
-			"azuredevops_agent_pool":                resourceAzureAgentPool(),
-			"azuredevops_group":                     resourceGroup(),
+			"azuredevops_build_definition":                     resourceBuildDefinition(),
+			"azuredevops_project":                              resourceProject(),
+			"azuredevops_variable_group":                       resourceVariableGroup(),
+			"azuredevops_serviceendpoint_github":               resourceServiceEndpointGitHub(),
+			"azuredevops_serviceendpoint_dockerhub":            resourceServiceEndpointDockerHub(),
+			"azuredevops_azure_git_repository":                 resourceAzureGitRepository(),
+			"azuredevops_user_entitlement":                     resourceUserEntitlement(),
+			"azuredevops_group_membership":                     resourceGroupMembership(),
+			"azuredevops_agent_pool":                           resourceAzureAgentPool(),
+			"azuredevops_group":                                resourceGroup(),
+			"azuredevops_workitem_field":                       resourceWorkItemField(),
+			"azuredevops_workitem_picklist":                    resourceWorkItemPicklist(),
+			"azuredevops_workitemtype":                         resourceWorkItemType(),
+			"azuredevops_workitemtype_field":                   resourceWorkItemTypeField(),
+			"azuredevops_workitemtype_state":                   resourceWorkItemTypeState(),
+			"azuredevops_workitemtype_rule":                    resourceWorkItemTypeRule(),
+			"azuredevops_workitemtype_group":                   resourceWorkItemTypeGroup(),
+			"azuredevops_project_properties":                   resourceProjectProperties(),
+			"azuredevops_team":                                 resourceTeam(),
+			"azuredevops_team_settings":                        resourceTeamSettings(),
+			"azuredevops_area":                                 resourceArea(),
+			"azuredevops_iteration":                            resourceIteration(),
+			"azuredevops_security_permissions":                 resourceSecurityPermissions(),
+			"azuredevops_project_permissions":                  resourceProjectPermissions(),
+			"azuredevops_git_permissions":                      resourceGitPermissions(),
+			"azuredevops_build_permissions":                    resourceBuildPermissions(),
+			"azuredevops_resource_role_assignment":             resourceResourceRoleAssignment(),
+			"azuredevops_area_permissions":                     resourceAreaPermissions(),
+			"azuredevops_iteration_permissions":                resourceIterationPermissions(),
+			"azuredevops_git_repository_file":                  resourceGitRepositoryFile(),
+			"azuredevops_git_branch":                           resourceGitBranch(),
+			"azuredevops_git_tag":                              resourceGitTag(),
+			"azuredevops_branch_policy_min_reviewers":          resourceBranchPolicyMinReviewers(),
+			"azuredevops_branch_policy_build_validation":       resourceBranchPolicyBuildValidation(),
+			"azuredevops_branch_policy_auto_reviewers":         resourceBranchPolicyAutoReviewers(),
+			"azuredevops_branch_policy_merge_types":            resourceBranchPolicyMergeTypes(),
+			"azuredevops_branch_policy_comment_resolution":     resourceBranchPolicyCommentResolution(),
+			"azuredevops_branch_policy_work_item_linking":      resourceBranchPolicyWorkItemLinking(),
+			"azuredevops_repository_policy_max_file_size":      resourceRepositoryPolicyMaxFileSize(),
+			"azuredevops_repository_policy_max_path_length":    resourceRepositoryPolicyMaxPathLength(),
+			"azuredevops_repository_policy_reserved_names":     resourceRepositoryPolicyReservedNames(),
+			"azuredevops_repository_policy_file_path_patterns": resourceRepositoryPolicyFilePathPatterns(),
+			"azuredevops_repository_policy_case_enforcement":   resourceRepositoryPolicyCaseEnforcement(),
+			"azuredevops_branch_policy_status_check":           resourceBranchPolicyStatusCheck(),
 		},
 		DataSourcesMap: map[string]*schema.Resource{
-			"azuredevops_group":    dataGroup(),
-			"azuredevops_projects": dataProjects(),
+			"azuredevops_group":               dataGroup(),
+			"azuredevops_projects":            dataProjects(),
+			"azuredevops_project_properties":  dataProjectProperties(),
+			"azuredevops_teams":               dataTeams(),
+			"azuredevops_git_repositories":    dataGitRepositories(),
+			"azuredevops_git_branches":        dataGitBranches(),
+			"azuredevops_git_repository_file": dataGitRepositoryFile(),
 		},
 		Schema: map[string]*schema.Schema{
 			"org_service_url": {
@@ -26,6 +26,40 @@ func TestAzureDevOpsProvider_HasChildResources(t *testing.T) {
 		"azuredevops_group_membership",
 		"azuredevops_group",
 		"azuredevops_agent_pool",
+		"azuredevops_workitem_field",
+		"azuredevops_workitem_picklist",
+		"azuredevops_workitemtype",
+		"azuredevops_workitemtype_field",
+		"azuredevops_workitemtype_state",
+		"azuredevops_workitemtype_rule",
+		"azuredevops_workitemtype_group",
+		"azuredevops_project_properties",
+		"azuredevops_team",
+		"azuredevops_team_settings",
+		"azuredevops_area",
+		"azuredevops_iteration",
+		"azuredevops_security_permissions",
+		"azuredevops_project_permissions",
+		"azuredevops_git_permissions",
+		"azuredevops_build_permissions",
+		"azuredevops_resource_role_assignment",
+		"azuredevops_area_permissions",
+		"azuredevops_iteration_permissions",
+		"azuredevops_git_repository_file",
+		"azuredevops_git_branch",
+		"azuredevops_git_tag",
+		"azuredevops_branch_policy_min_reviewers",
+		"azuredevops_branch_policy_build_validation",
+		"azuredevops_branch_policy_auto_reviewers",
+		"azuredevops_branch_policy_merge_types",
+		"azuredevops_branch_policy_comment_resolution",
+		"azuredevops_branch_policy_work_item_linking",
+		"azuredevops_repository_policy_max_file_size",
+		"azuredevops_repository_policy_max_path_length",
+		"azuredevops_repository_policy_reserved_names",
+		"azuredevops_repository_policy_file_path_patterns",
+		"azuredevops_repository_policy_case_enforcement",
+		"azuredevops_branch_policy_status_check",
 	}
 
 	resources := provider.ResourcesMap
@@ -41,6 +75,11 @@ func TestAzureDevOpsProvider_HasChildDataSources(t *testing.T) {
 	expectedDataSources := []string{
 		"azuredevops_group",
 		"azuredevops_projects",
+		"azuredevops_project_properties",
+		"azuredevops_teams",
+		"azuredevops_git_repositories",
+		"azuredevops_git_branches",
+		"azuredevops_git_repository_file",
 	}
 
 	dataSources := provider.DataSourcesMap
@@ -1,16 +1,63 @@
 package azuredevops
 
 import (
+	"bytes"
+	"encoding/base64"
+	"encoding/json"
 	"fmt"
+	"io"
+	"io/ioutil"
+	"log"
+	"net/http"
+	"strings"
+	"time"
+
 	"github.com/google/uuid"
 	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/helper/validation"
 	"github.com/microsoft/azure-devops-go-api/azuredevops/core"
 	"github.com/microsoft/azure-devops-go-api/azuredevops/git"
+	"github.com/microsoft/azure-devops-go-api/azuredevops/policy"
+	"github.com/microsoft/azure-devops-go-api/azuredevops/serviceendpoint"
+	crud "github.com/microsoft/terraform-provider-azuredevops/azuredevops/crud/branchpolicy"
 	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/utils/config"
 	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/utils/converter"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/utils/validate"
+)
+
+// Interval in which the status of the import of a repository is checked
+var gitImportPollInterval = 5 * time.Second
+
+// Location of the repositories API (_apis/git/repositories/{repositoryId}). The isDisabled flag of a repository is
+// not part of the Azure DevOps Go SDK, so it is read and updated through the Git client directly.
+var gitRepositoriesLocationID = uuid.MustParse("225f7195-f9c7-4d14-ab28-a83f7ff77e1f")
+
+const gitRepositoryDisabledAPIVersion = "6.0-preview.1"
+
+// Forking and GVFS-only mode are configured in the Git repository settings policy of the repository. Forks are not
+// allowed if allowedForkTargets is 0.
+const (
+	gitRepositorySettingsAllowedForkTargets = "allowedForkTargets"
+	gitRepositorySettingsGvfsOnly           = "gvfsOnly"
 )
 
+// azDOGitRepositoryState is the part of a repository that is not covered by git.GitRepository
+type azDOGitRepositoryState struct {
+	IsDisabled *bool `json:"isDisabled,omitempty"`
+}
+
+// gitRepositoryStateClient reads and changes whether a repository is disabled. It is an interface so that the unit
+// tests, which use mocked SDK clients, can replace the calls of the repositories API.
+type gitRepositoryStateClient interface {
+	GetDisabled(clients *config.AggregatedClient, repoID string) (bool, error)
+	SetDisabled(clients *config.AggregatedClient, repoID string, disabled bool) error
+}
+
+var azureGitRepositoryStateClient gitRepositoryStateClient = &restGitRepositoryStateClient{}
+
+// restGitRepositoryStateClient sends the requests through the Git client of the SDK
+type restGitRepositoryStateClient struct{}
+
 func resourceAzureGitRepository() *schema.Resource {
 	return &schema.Resource{
 		Create: resourceAzureGitRepositoryCreate,
@@ -18,19 +65,45 @@ func resourceAzureGitRepository() *schema.Resource {
 		Update: resourceAzureGitRepositoryUpdate,
 		Delete: resourceAzureGitRepositoryDelete,
 
+		// the create timeout limits the time to wait for the import of a repository to finish
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(30 * time.Minute),
+		},
+
 		Schema: map[string]*schema.Schema{
+			// repositories cannot be moved to another project through the API, so they are recreated instead
 			"project_id": {
-				Type:     schema.TypeString,
-				Required: true,
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validate.UUID,
 			},
 			"name": {
 				Type:     schema.TypeString,
 				ForceNew: false,
 				Required: true,
 			},
+			"disabled": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+			"forks_allowed": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  true,
+			},
+			"gvfs_only": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
 			"default_branch": {
-				Type:     schema.TypeString,
-				Computed: true,
+				Type:             schema.TypeString,
+				Optional:         true,
+				Computed:         true,
+				ValidateFunc:     validate.NoEmptyStrings,
+				DiffSuppressFunc: suppressBranchNamePrefixDiff,
 			},
 			"is_fork": {
 				Type:     schema.TypeBool,
@@ -78,6 +151,68 @@ func resourceAzureGitRepository() *schema.Resource {
 							Optional: true,
 							Default:  "",
 						},
+						"service_endpoint_id": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							ValidateFunc: validate.UUID,
+						},
+						"username": {
+							Type:     schema.TypeString,
+							Optional: true,
+						},
+						"password": {
+							Type:      schema.TypeString,
+							Optional:  true,
+							Sensitive: true,
+						},
+						"source_repository_id": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							ValidateFunc: validate.UUID,
+						},
+						"source_project_id": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							ValidateFunc: validate.UUID,
+						},
+						"default_branch_only": {
+							Type:     schema.TypeBool,
+							Optional: true,
+							Default:  false,
+						},
+						"initial_branch": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							Default:      "master",
+							ValidateFunc: validate.NoEmptyStrings,
+						},
+						"commit_message": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							Default:      "Initial commit.",
+							ValidateFunc: validate.NoEmptyStrings,
+						},
+						"file": {
+							Type:     schema.TypeList,
+							Optional: true,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"path": {
+										Type:         schema.TypeString,
+										Required:     true,
+										ValidateFunc: validate.NoEmptyStrings,
+									},
+									"content": {
+										Type:     schema.TypeString,
+										Optional: true,
+									},
+									"source": {
+										Type:     schema.TypeString,
+										Optional: true,
+									},
+								},
+							},
+						},
 					},
 				},
 			},
@@ -87,9 +222,26 @@ func resourceAzureGitRepository() *schema.Resource {
 
 // A helper type that is used for transient info only used during repo creation
 type repoInitializationMeta struct {
-	initType   string
-	sourceType string
-	sourceURL  string
+	initType          string
+	sourceType        string
+	sourceURL         string
+	serviceEndpointID string
+	username          string
+	password          string
+	sourceRepoID      string
+	sourceProjectID   string
+	defaultBranchOnly bool
+	initialBranch     string
+	commitMessage     string
+	files             []repoInitializationFile
+}
+
+// A file that is added to the repository by the initial commit. The content is either given inline or read
+// from the local file at source.
+type repoInitializationFile struct {
+	path    string
+	content string
+	source  string
 }
 
 func resourceAzureGitRepositoryCreate(d *schema.ResourceData, m interface{}) error {
@@ -99,24 +251,61 @@ func resourceAzureGitRepositoryCreate(d *schema.ResourceData, m interface{}) err
 		return fmt.Errorf("Error expanding repository resource data: %+v", err)
 	}
 
-	createdRepo, err := createAzureGitRepository(clients, repo.Name, projectID)
+	createdRepo, err := createAzureGitRepository(clients, repo.Name, projectID, initialization)
 	if err != nil {
 		return fmt.Errorf("Error creating repository in Azure DevOps: %+v", err)
 	}
+	// the repository exists from now on, so that it is tainted if the initialization fails
+	d.SetId(createdRepo.Id.String())
 
 	if initialization.initType == "Clean" {
-		err = initializeAzureGitRepository(clients, createdRepo)
+		err = initializeAzureGitRepository(clients, createdRepo, initialization)
 		if err != nil {
 			return fmt.Errorf("Error initializing repository in Azure DevOps: %+v", err)
 		}
 	}
 
+	if initialization.initType == "Import" {
+		err = importAzureGitRepository(clients, createdRepo, initialization, d.Timeout(schema.TimeoutCreate))
+		if err != nil {
+			return fmt.Errorf("Error importing repository in Azure DevOps: %+v", err)
+		}
+	}
+
+	if defaultBranch, ok := d.GetOk("default_branch"); ok {
+		repo.Id = createdRepo.Id
+		err = setAzureGitRepositoryDefaultBranch(clients, repo, projectID, defaultBranch.(string))
+		if err != nil {
+			return err
+		}
+		createdRepo, err = updateAzureGitRepository(clients, repo, projectID)
+		if err != nil {
+			return fmt.Errorf("Error updating default branch of repository in Azure DevOps: %+v", err)
+		}
+	}
+
+	forksAllowed := d.Get("forks_allowed").(bool)
+	gvfsOnly := d.Get("gvfs_only").(bool)
+	if !forksAllowed || gvfsOnly {
+		err = setAzureGitRepositorySettings(clients, projectID.String(), createdRepo.Id.String(), forksAllowed, gvfsOnly)
+		if err != nil {
+			return err
+		}
+	}
+
+	if d.Get("disabled").(bool) {
+		err = azureGitRepositoryStateClient.SetDisabled(clients, createdRepo.Id.String(), true)
+		if err != nil {
+			return err
+		}
+	}
+
 	flattenAzureGitRepository(d, createdRepo)
 
 	return resourceAzureGitRepositoryRead(d, m)
 }
 
-func createAzureGitRepository(clients *config.AggregatedClient, repoName *string, projectID *uuid.UUID) (*git.GitRepository, error) {
+func createAzureGitRepository(clients *config.AggregatedClient, repoName *string, projectID *uuid.UUID, initialization *repoInitializationMeta) (*git.GitRepository, error) {
 	args := git.CreateRepositoryArgs{
 		GitRepositoryToCreate: &git.GitRepositoryCreateOptions{
 			Name: repoName,
@@ -125,37 +314,101 @@ func createAzureGitRepository(clients *config.AggregatedClient, repoName *string
 			},
 		},
 	}
+
+	if initialization.initType == "Fork" {
+		parentRepository, sourceRef, err := expandAzureGitRepositoryParent(clients, projectID, initialization)
+		if err != nil {
+			return nil, err
+		}
+		args.GitRepositoryToCreate.ParentRepository = parentRepository
+		args.SourceRef = sourceRef
+	}
+
 	createdRepository, err := clients.GitReposClient.CreateRepository(clients.Ctx, args)
 
 	return createdRepository, err
 }
 
-func initializeAzureGitRepository(clients *config.AggregatedClient, repo *git.GitRepository) error {
+// Builds the reference to the repository that is forked. The source repository is located in the project of the
+// new repository unless a source project is given. If only the default branch is forked, the default branch of the
+// source repository is looked up and passed as the source ref of the fork.
+func expandAzureGitRepositoryParent(clients *config.AggregatedClient, projectID *uuid.UUID, initialization *repoInitializationMeta) (*git.GitRepositoryRef, *string, error) {
+	sourceRepoID, err := uuid.Parse(initialization.sourceRepoID)
+	if err != nil {
+		return nil, nil, fmt.Errorf("Invalid source repository UUID: %s", initialization.sourceRepoID)
+	}
+	sourceProjectID := *projectID
+	if initialization.sourceProjectID != "" {
+		sourceProjectID, err = uuid.Parse(initialization.sourceProjectID)
+		if err != nil {
+			return nil, nil, fmt.Errorf("Invalid source project UUID: %s", initialization.sourceProjectID)
+		}
+	}
+
+	parentRepository := &git.GitRepositoryRef{
+		Id: &sourceRepoID,
+		Project: &core.TeamProjectReference{
+			Id: &sourceProjectID,
+		},
+	}
+	if !initialization.defaultBranchOnly {
+		return parentRepository, nil, nil
+	}
+
+	sourceRepo, err := azureGitRepositoryRead(clients, sourceRepoID.String(), "", sourceProjectID.String())
+	if err != nil {
+		return nil, nil, fmt.Errorf("Error reading source repository %s: %+v", sourceRepoID.String(), err)
+	}
+	if sourceRepo.DefaultBranch == nil || *sourceRepo.DefaultBranch == "" {
+		return nil, nil, fmt.Errorf("Source repository %s does not have a default branch", sourceRepoID.String())
+	}
+	return parentRepository, sourceRepo.DefaultBranch, nil
+}
+
+// Pushes the initial commit to the initial branch of the repository. Without any configured files, the commit
+// adds a readme.md that contains the name of the project.
+func initializeAzureGitRepository(clients *config.AggregatedClient, repo *git.GitRepository, initialization *repoInitializationMeta) error {
+	changes := []interface{}{}
+	for _, file := range initialization.files {
+		content, err := expandRepoInitializationFileContent(&file)
+		if err != nil {
+			return err
+		}
+		changes = append(changes, git.Change{
+			ChangeType: &git.VersionControlChangeTypeValues.Add,
+			Item: git.GitItem{
+				Path: converter.String("/" + strings.TrimPrefix(file.path, "/")),
+			},
+			NewContent: content,
+		})
+	}
+	if len(changes) == 0 {
+		changes = append(changes, git.Change{
+			ChangeType: &git.VersionControlChangeTypeValues.Add,
+			Item: git.GitItem{
+				Path: converter.String("/readme.md"),
+			},
+			NewContent: &git.ItemContent{
+				ContentType: &git.ItemContentTypeValues.RawText,
+				Content:     repo.Project.Name,
+			},
+		})
+	}
+
 	args := git.CreatePushArgs{
 		RepositoryId: repo.Name,
 		Project:      repo.Project.Name,
 		Push: &git.GitPush{
 			RefUpdates: &[]git.GitRefUpdate{
 				{
-					Name:        converter.String("refs/heads/master"),
+					Name:        converter.String(withBranchPrefix(initialization.initialBranch)),
 					OldObjectId: converter.String("0000000000000000000000000000000000000000"),
 				},
 			},
 			Commits: &[]git.GitCommitRef{
 				{
-					Comment: converter.String("Initial commit."),
-					Changes: &[]interface{}{
-						git.Change{
-							ChangeType: &git.VersionControlChangeTypeValues.Add,
-							Item: git.GitItem{
-								Path: converter.String("/readme.md"),
-							},
-							NewContent: &git.ItemContent{
-								ContentType: &git.ItemContentTypeValues.RawText,
-								Content:     repo.Project.Name,
-							},
-						},
-					},
+					Comment: converter.String(initialization.commitMessage),
+					Changes: &changes,
 				},
 			},
 		},
@@ -166,6 +419,137 @@ func initializeAzureGitRepository(clients *config.AggregatedClient, repo *git.Gi
 	return err
 }
 
+// Local files are pushed base64 encoded so that binary files are added unchanged
+func expandRepoInitializationFileContent(file *repoInitializationFile) (*git.ItemContent, error) {
+	if file.source == "" {
+		return &git.ItemContent{
+			ContentType: &git.ItemContentTypeValues.RawText,
+			Content:     converter.String(file.content),
+		}, nil
+	}
+
+	content, err := ioutil.ReadFile(file.source)
+	if err != nil {
+		return nil, fmt.Errorf("Error reading file %s: %+v", file.source, err)
+	}
+	return &git.ItemContent{
+		ContentType: &git.ItemContentTypeValues.Base64Encoded,
+		Content:     converter.String(base64.StdEncoding.EncodeToString(content)),
+	}, nil
+}
+
+// Branch names can be configured with or without the refs/heads/ prefix
+func withBranchPrefix(branchName string) string {
+	if strings.HasPrefix(branchName, "refs/heads/") {
+		return branchName
+	}
+	return "refs/heads/" + branchName
+}
+
+// Imports the source repository into the repository. Sources that require authentication are accessed through
+// a service endpoint; if a username and password are given, a service endpoint is created for the import and
+// removed by the service after the import is done.
+func importAzureGitRepository(clients *config.AggregatedClient, repo *git.GitRepository, initialization *repoInitializationMeta, timeout time.Duration) error {
+	projectID := repo.Project.Id.String()
+	parameters := &git.GitImportRequestParameters{
+		GitSource: &git.GitImportGitSource{
+			Url: converter.String(initialization.sourceURL),
+		},
+	}
+
+	if initialization.serviceEndpointID != "" {
+		serviceEndpointID, err := uuid.Parse(initialization.serviceEndpointID)
+		if err != nil {
+			return fmt.Errorf("Invalid service endpoint UUID: %s", initialization.serviceEndpointID)
+		}
+		parameters.ServiceEndpointId = &serviceEndpointID
+	} else if initialization.username != "" {
+		serviceEndpoint, err := createGitImportServiceEndpoint(clients, repo, initialization)
+		if err != nil {
+			return fmt.Errorf("Error creating service endpoint for the import: %+v", err)
+		}
+		parameters.ServiceEndpointId = serviceEndpoint.Id
+		parameters.DeleteServiceEndpointAfterImportIsDone = converter.Bool(true)
+	}
+
+	importRequest, err := clients.GitReposClient.CreateImportRequest(clients.Ctx, git.CreateImportRequestArgs{
+		ImportRequest: &git.GitImportRequest{
+			Parameters: parameters,
+		},
+		Project:      converter.String(projectID),
+		RepositoryId: converter.String(repo.Id.String()),
+	})
+	if err != nil {
+		if parameters.DeleteServiceEndpointAfterImportIsDone != nil {
+			deleteErr := clients.ServiceEndpointClient.DeleteServiceEndpoint(clients.Ctx, serviceendpoint.DeleteServiceEndpointArgs{
+				Project:    converter.String(projectID),
+				EndpointId: parameters.ServiceEndpointId,
+			})
+			if deleteErr != nil {
+				log.Printf("[WARN] Unable to delete service endpoint %s created for the import: %+v", parameters.ServiceEndpointId.String(), deleteErr)
+			}
+		}
+		return err
+	}
+
+	return waitForGitImportSuccess(clients, projectID, repo.Id.String(), importRequest.ImportRequestId, timeout)
+}
+
+func createGitImportServiceEndpoint(clients *config.AggregatedClient, repo *git.GitRepository, initialization *repoInitializationMeta) (*serviceendpoint.ServiceEndpoint, error) {
+	return clients.ServiceEndpointClient.CreateServiceEndpoint(clients.Ctx, serviceendpoint.CreateServiceEndpointArgs{
+		Endpoint: &serviceendpoint.ServiceEndpoint{
+			Name:  converter.String(fmt.Sprintf("Import %s (%s)", converter.ToString(repo.Name, ""), repo.Id.String())),
+			Owner: converter.String("library"),
+			Type:  converter.String("git"),
+			Url:   converter.String(initialization.sourceURL),
+			Authorization: &serviceendpoint.EndpointAuthorization{
+				Scheme: converter.String("UsernamePassword"),
+				Parameters: &map[string]string{
+					"username": initialization.username,
+					"password": initialization.password,
+				},
+			},
+		},
+		Project: converter.String(repo.Project.Id.String()),
+	})
+}
+
+func waitForGitImportSuccess(clients *config.AggregatedClient, projectID string, repoID string, importRequestID *int, timeout time.Duration) error {
+	timeoutReached := time.After(timeout)
+	ticker := time.NewTicker(gitImportPollInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ticker.C:
+			importRequest, err := clients.GitReposClient.GetImportRequest(clients.Ctx, git.GetImportRequestArgs{
+				Project:         converter.String(projectID),
+				RepositoryId:    converter.String(repoID),
+				ImportRequestId: importRequestID,
+			})
+			if err != nil {
+				return err
+			}
+			if importRequest == nil || importRequest.Status == nil {
+				return fmt.Errorf("Status of import request %d is not available", *importRequestID)
+			}
+
+			switch *importRequest.Status {
+			case git.GitAsyncOperationStatusValues.Completed:
+				return nil
+			case git.GitAsyncOperationStatusValues.Failed, git.GitAsyncOperationStatusValues.Abandoned:
+				message := "no details available"
+				if importRequest.DetailedStatus != nil && importRequest.DetailedStatus.ErrorMessage != nil {
+					message = *importRequest.DetailedStatus.ErrorMessage
+				}
+				return fmt.Errorf("Import %s: %s", *importRequest.Status, message)
+			}
+		case <-timeoutReached:
+			return fmt.Errorf("Import was not completed after %s", timeout)
+		}
+	}
+}
+
 func resourceAzureGitRepositoryRead(d *schema.ResourceData, m interface{}) error {
 	repoID := d.Id()
 	repoName := d.Get("name").(string)
@@ -178,6 +562,19 @@ func resourceAzureGitRepositoryRead(d *schema.ResourceData, m interface{}) error
 	}
 
 	flattenAzureGitRepository(d, repo)
+
+	disabled, err := azureGitRepositoryStateClient.GetDisabled(clients, repo.Id.String())
+	if err != nil {
+		return err
+	}
+	d.Set("disabled", disabled)
+
+	forksAllowed, gvfsOnly, err := getAzureGitRepositorySettings(clients, repo.Project.Id.String(), repo.Id.String())
+	if err != nil {
+		return err
+	}
+	d.Set("forks_allowed", forksAllowed)
+	d.Set("gvfs_only", gvfsOnly)
 	return nil
 }
 
@@ -188,12 +585,47 @@ func resourceAzureGitRepositoryUpdate(d *schema.ResourceData, m interface{}) err
 		return fmt.Errorf("Error converting terraform data model to AzDO project reference: %+v", err)
 	}
 
-	repo, err = updateAzureGitRepository(clients, repo, projectID)
-	if err != nil {
-		return fmt.Errorf("Error updating repository in Azure DevOps: %+v", err)
+	// a disabled repository cannot be changed, so it is enabled before and disabled after all other changes
+	disabled := d.Get("disabled").(bool)
+	reenabled := d.HasChange("disabled") && !disabled
+	if reenabled {
+		err = azureGitRepositoryStateClient.SetDisabled(clients, repo.Id.String(), false)
+		if err != nil {
+			return err
+		}
+	}
+
+	// the repository itself is only updated if one of its own attributes changed, as this fails for a repository
+	// that stays disabled
+	if reenabled || d.HasChange("name") || d.HasChange("default_branch") {
+		if d.HasChange("default_branch") {
+			err = setAzureGitRepositoryDefaultBranch(clients, repo, projectID, d.Get("default_branch").(string))
+			if err != nil {
+				return err
+			}
+		}
+
+		updatedRepo, err := updateAzureGitRepository(clients, repo, projectID)
+		if err != nil {
+			return fmt.Errorf("Error updating repository in Azure DevOps: %+v", err)
+		}
+		flattenAzureGitRepository(d, updatedRepo)
+	}
+
+	if d.HasChange("forks_allowed") || d.HasChange("gvfs_only") {
+		err = setAzureGitRepositorySettings(clients, projectID.String(), repo.Id.String(), d.Get("forks_allowed").(bool), d.Get("gvfs_only").(bool))
+		if err != nil {
+			return err
+		}
+	}
+
+	if d.HasChange("disabled") && disabled {
+		err = azureGitRepositoryStateClient.SetDisabled(clients, repo.Id.String(), true)
+		if err != nil {
+			return err
+		}
 	}
 
-	flattenAzureGitRepository(d, repo)
 	return resourceAzureGitRepositoryRead(d, m)
 }
 
@@ -208,6 +640,202 @@ func updateAzureGitRepository(clients *config.AggregatedClient, repository *git.
 		})
 }
 
+// Sets the default branch of the repository after verifying that the branch exists, as the service accepts
+// branches that do not exist
+func setAzureGitRepositoryDefaultBranch(clients *config.AggregatedClient, repo *git.GitRepository, projectID *uuid.UUID, branchName string) error {
+	branchName = withBranchPrefix(branchName)
+	refs, err := clients.GitReposClient.GetRefs(clients.Ctx, git.GetRefsArgs{
+		RepositoryId: converter.String(repo.Id.String()),
+		Project:      converter.String(projectID.String()),
+		Filter:       converter.String(strings.TrimPrefix(branchName, "refs/")),
+	})
+	if err != nil {
+		return fmt.Errorf("Error reading branches of repository %s: %+v", repo.Id.String(), err)
+	}
+	if refs == nil {
+		return fmt.Errorf("No branches returned for repository %s", repo.Id.String())
+	}
+
+	for _, ref := range refs.Value {
+		if converter.ToString(ref.Name, "") == branchName {
+			repo.DefaultBranch = converter.String(branchName)
+			return nil
+		}
+	}
+	return fmt.Errorf("Branch %s does not exist in repository %s and cannot be the default branch", branchName, repo.Id.String())
+}
+
+// GetDisabled reads whether the repository is disabled
+func (c *restGitRepositoryStateClient) GetDisabled(clients *config.AggregatedClient, repoID string) (bool, error) {
+	var state azDOGitRepositoryState
+	err := sendAzureGitRepositoryStateRequest(clients, http.MethodGet, repoID, nil, &state)
+	if err != nil {
+		return false, fmt.Errorf("Error reading state of repository %s: %+v", repoID, err)
+	}
+	return converter.ToBool(state.IsDisabled, false), nil
+}
+
+// SetDisabled disables or enables the repository
+func (c *restGitRepositoryStateClient) SetDisabled(clients *config.AggregatedClient, repoID string, disabled bool) error {
+	err := sendAzureGitRepositoryStateRequest(clients, http.MethodPatch, repoID, &azDOGitRepositoryState{
+		IsDisabled: converter.Bool(disabled),
+	}, nil)
+	if err != nil {
+		return fmt.Errorf("Error changing state of repository %s: %+v", repoID, err)
+	}
+	return nil
+}
+
+// If result is set, the repository returned by the API is unmarshalled into it
+func sendAzureGitRepositoryStateRequest(clients *config.AggregatedClient, method string, repoID string, body interface{}, result interface{}) error {
+	clientImpl, ok := clients.GitReposClient.(*git.ClientImpl)
+	if !ok {
+		return fmt.Errorf("Invalid Azure DevOps Git client implementation")
+	}
+
+	routeValues := map[string]string{
+		"repositoryId": repoID,
+	}
+	var requestBody io.Reader
+	mediaType := ""
+	if body != nil {
+		content, err := json.Marshal(body)
+		if err != nil {
+			return err
+		}
+		requestBody = bytes.NewReader(content)
+		mediaType = "application/json"
+	}
+
+	resp, err := clientImpl.Client.Send(clients.Ctx, method, gitRepositoriesLocationID, gitRepositoryDisabledAPIVersion, routeValues, nil, requestBody, mediaType, "application/json", nil)
+	if err != nil {
+		return err
+	}
+	if result == nil {
+		return nil
+	}
+	return clientImpl.Client.UnmarshalBody(resp, result)
+}
+
+// Reads whether forks are allowed and whether the repository only accepts GVFS clients. Without a settings policy,
+// the service defaults apply.
+func getAzureGitRepositorySettings(clients *config.AggregatedClient, projectID string, repoID string) (bool, bool, error) {
+	settingsPolicy, err := getAzureGitRepositorySettingsPolicy(clients, projectID, repoID)
+	if err != nil {
+		return false, false, err
+	}
+	if settingsPolicy == nil {
+		return true, false, nil
+	}
+
+	settings, _ := settingsPolicy.Settings.(map[string]interface{})
+	forksAllowed := true
+	if _, ok := settings[gitRepositorySettingsAllowedForkTargets]; ok {
+		forksAllowed = crud.GetInt(settings, gitRepositorySettingsAllowedForkTargets) != 0
+	}
+	return forksAllowed, crud.GetBool(settings, gitRepositorySettingsGvfsOnly), nil
+}
+
+// Updates the settings policy of the repository, or creates it if it does not exist yet. Other settings of an
+// existing policy, e.g. case enforcement, are kept with the same rule that azuredevops_repository_policy_case_enforcement
+// uses, so that both resources can share the policy.
+func setAzureGitRepositorySettings(clients *config.AggregatedClient, projectID string, repoID string, forksAllowed bool, gvfsOnly bool) error {
+	settingsPolicy, err := getAzureGitRepositorySettingsPolicy(clients, projectID, repoID)
+	if err != nil {
+		return err
+	}
+
+	allowedForkTargets := 0
+	if forksAllowed {
+		allowedForkTargets = 1
+	}
+
+	if settingsPolicy == nil {
+		_, err = clients.PolicyClient.CreatePolicyConfiguration(clients.Ctx, policy.CreatePolicyConfigurationArgs{
+			Project: converter.String(projectID),
+			Configuration: &policy.PolicyConfiguration{
+				IsEnabled:  converter.Bool(true),
+				IsBlocking: converter.Bool(true),
+				Type: &policy.PolicyTypeRef{
+					Id: &gitRepositorySettingsPolicyType,
+				},
+				Settings: map[string]interface{}{
+					gitRepositorySettingsAllowedForkTargets: allowedForkTargets,
+					gitRepositorySettingsGvfsOnly:           gvfsOnly,
+					"scope": []map[string]interface{}{{
+						"repositoryId": repoID,
+					}},
+				},
+			},
+		})
+	} else {
+		settings := map[string]interface{}{
+			gitRepositorySettingsAllowedForkTargets: allowedForkTargets,
+			gitRepositorySettingsGvfsOnly:           gvfsOnly,
+		}
+		existingSettings, _ := settingsPolicy.Settings.(map[string]interface{})
+		crud.MergeSettings(settings, existingSettings)
+		settingsPolicy.Settings = settings
+
+		_, err = clients.PolicyClient.UpdatePolicyConfiguration(clients.Ctx, policy.UpdatePolicyConfigurationArgs{
+			Project:         converter.String(projectID),
+			ConfigurationId: settingsPolicy.Id,
+			Configuration:   settingsPolicy,
+		})
+	}
+	if err != nil {
+		return fmt.Errorf("Error updating settings of repository %s: %+v", repoID, err)
+	}
+	return nil
+}
+
+// Looks up the settings policy that is scoped to the repository. Returns nil if the policy does not exist.
+func getAzureGitRepositorySettingsPolicy(clients *config.AggregatedClient, projectID string, repoID string) (*policy.PolicyConfiguration, error) {
+	var currentToken string
+	for hasMore := true; hasMore; {
+		args := policy.GetPolicyConfigurationsArgs{
+			Project:    converter.String(projectID),
+			PolicyType: &gitRepositorySettingsPolicyType,
+		}
+		if currentToken != "" {
+			args.ContinuationToken = converter.String(currentToken)
+		}
+
+		response, err := clients.PolicyClient.GetPolicyConfigurations(clients.Ctx, args)
+		if err != nil {
+			return nil, fmt.Errorf("Error reading settings of repository %s: %+v", repoID, err)
+		}
+
+		for _, configuration := range response.Value {
+			if isPolicyScopedToRepository(&configuration, repoID) {
+				return &configuration, nil
+			}
+		}
+		currentToken = response.ContinuationToken
+		hasMore = currentToken != ""
+	}
+	return nil, nil
+}
+
+// Only a policy that applies to this repository alone is used, so that policies of other repositories or of the whole
+// project are never changed
+func isPolicyScopedToRepository(configuration *policy.PolicyConfiguration, repoID string) bool {
+	settings, ok := configuration.Settings.(map[string]interface{})
+	if !ok {
+		return false
+	}
+	scopes, ok := settings["scope"].([]interface{})
+	if !ok || len(scopes) != 1 {
+		return false
+	}
+	scopeValues, ok := scopes[0].(map[string]interface{})
+	if !ok || len(scopeValues) != 1 {
+		return false
+	}
+	scopeRepoID, ok := scopeValues["repositoryId"].(string)
+	return ok && strings.EqualFold(scopeRepoID, repoID)
+}
+
 func resourceAzureGitRepositoryDelete(d *schema.ResourceData, m interface{}) error {
 	repoID := d.Id()
 	clients := m.(*config.AggregatedClient)
@@ -244,7 +872,7 @@ func flattenAzureGitRepository(d *schema.ResourceData, repository *git.GitReposi
 	d.Set("name", converter.ToString(repository.Name, ""))
 	d.Set("project_id", repository.Project.Id.String())
 	d.Set("default_branch", converter.ToString(repository.DefaultBranch, ""))
-	d.Set("is_fork", repository.IsFork)
+	d.Set("is_fork", converter.ToBool(repository.IsFork, false))
 	d.Set("remote_url", converter.ToString(repository.RemoteUrl, ""))
 	d.Set("size", repository.Size)
 	d.Set("ssh_url", converter.ToString(repository.SshUrl, ""))
@@ -282,19 +910,75 @@ func expandAzureGitRepository(d *schema.ResourceData) (*git.GitRepository, *repo
 	initValues := initData[0].(map[string]interface{})
 
 	initialization := &repoInitializationMeta{
-		initType:   initValues["init_type"].(string),
-		sourceType: initValues["source_type"].(string),
-		sourceURL:  initValues["source_url"].(string),
+		initType:          initValues["init_type"].(string),
+		sourceType:        initValues["source_type"].(string),
+		sourceURL:         initValues["source_url"].(string),
+		serviceEndpointID: initValues["service_endpoint_id"].(string),
+		username:          initValues["username"].(string),
+		password:          initValues["password"].(string),
+		sourceRepoID:      initValues["source_repository_id"].(string),
+		sourceProjectID:   initValues["source_project_id"].(string),
+		defaultBranchOnly: initValues["default_branch_only"].(bool),
+		initialBranch:     initValues["initial_branch"].(string),
+		commitMessage:     initValues["commit_message"].(string),
 	}
 
-	if initialization.initType == "Fork" || initialization.initType == "Import" {
-		return nil, nil, nil, fmt.Errorf("Initialization strategy not implemented: %s", initialization.initType)
+	for _, fileData := range initValues["file"].([]interface{}) {
+		fileValues := fileData.(map[string]interface{})
+		initialization.files = append(initialization.files, repoInitializationFile{
+			path:    fileValues["path"].(string),
+			content: fileValues["content"].(string),
+			source:  fileValues["source"].(string),
+		})
 	}
 
-	if initialization.initType == "Clean" {
+	if initialization.initType == "Import" {
+		if err := validateImportInitialization(initialization); err != nil {
+			return nil, nil, nil, err
+		}
+	} else {
 		initialization.sourceType = ""
 		initialization.sourceURL = ""
+		initialization.serviceEndpointID = ""
+		initialization.username = ""
+		initialization.password = ""
+	}
+
+	if initialization.initType == "Fork" {
+		if initialization.sourceRepoID == "" {
+			return nil, nil, nil, fmt.Errorf("A source_repository_id is required to fork a repository")
+		}
+	} else {
+		initialization.sourceRepoID = ""
+		initialization.sourceProjectID = ""
+		initialization.defaultBranchOnly = false
+	}
+
+	if initialization.initType == "Clean" {
+		for _, file := range initialization.files {
+			if file.content != "" && file.source != "" {
+				return nil, nil, nil, fmt.Errorf("Either content or source can be set for file %s, not both", file.path)
+			}
+		}
+	} else if len(initialization.files) > 0 {
+		return nil, nil, nil, fmt.Errorf("Files can only be added to repositories with init_type Clean")
 	}
 
 	return repo, initialization, &projectID, nil
 }
+
+func validateImportInitialization(initialization *repoInitializationMeta) error {
+	if initialization.sourceType != "" && !strings.EqualFold(initialization.sourceType, "Git") {
+		return fmt.Errorf("Unsupported import source_type %s. Only Git repositories can be imported", initialization.sourceType)
+	}
+	if initialization.sourceURL == "" {
+		return fmt.Errorf("A source_url is required to import a repository")
+	}
+	if initialization.serviceEndpointID != "" && initialization.username != "" {
+		return fmt.Errorf("Either a service_endpoint_id or a username and password can be used to import a repository, not both")
+	}
+	if initialization.username == "" && initialization.password != "" {
+		return fmt.Errorf("A username is required if a password is set to import a repository")
+	}
+	return nil
+}
@@ -4,9 +4,14 @@ package azuredevops
 
 import (
 	"context"
+	"encoding/json"
 	"errors"
 	"fmt"
+	"io/ioutil"
+	"os"
+	"regexp"
 	"testing"
+	"time"
 
 	"github.com/hashicorp/terraform-plugin-sdk/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
@@ -22,6 +27,8 @@ import (
 	"github.com/google/uuid"
 	"github.com/microsoft/azure-devops-go-api/azuredevops/core"
 	"github.com/microsoft/azure-devops-go-api/azuredevops/git"
+	"github.com/microsoft/azure-devops-go-api/azuredevops/policy"
+	"github.com/microsoft/azure-devops-go-api/azuredevops/serviceendpoint"
 	"github.com/stretchr/testify/require"
 )
 
@@ -81,7 +88,10 @@ func TestAzureGitRepo_Update_DoesNotSwallowErrorFromFailedCreateCall(t *testing.
 	ctrl := gomock.NewController(t)
 	defer ctrl.Finish()
 
-	resourceData := schema.TestResourceDataRaw(t, resourceAzureGitRepository().Schema, nil)
+	// the repository is only updated if one of its attributes changed
+	resourceData := schema.TestResourceDataRaw(t, resourceAzureGitRepository().Schema, map[string]interface{}{
+		"name": *testAzureGitRepository.Name,
+	})
 	flattenAzureGitRepository(resourceData, &testAzureGitRepository)
 	configureCleanInitialization(resourceData)
 
@@ -130,6 +140,348 @@ func TestAzureGitRepo_FlattenExpand_RoundTrip(t *testing.T) {
 	require.Equal(t, repoInitialization.sourceURL, "")
 }
 
+// verifies that a fork requires the repository to fork
+func TestAzureGitRepo_Expand_ValidatesForkInitialization(t *testing.T) {
+	resourceData := schema.TestResourceDataRaw(t, resourceAzureGitRepository().Schema, nil)
+	flattenAzureGitRepository(resourceData, &testAzureGitRepository)
+
+	resourceData.Set("initialization", &[]map[string]interface{}{{"init_type": "Fork"}})
+	_, _, _, err := expandAzureGitRepository(resourceData)
+	require.Equal(t, "A source_repository_id is required to fork a repository", err.Error())
+
+	sourceRepoID := uuid.New().String()
+	resourceData.Set("initialization", &[]map[string]interface{}{
+		{"init_type": "Fork", "source_repository_id": sourceRepoID, "default_branch_only": true},
+	})
+	_, initialization, _, err := expandAzureGitRepository(resourceData)
+	require.Nil(t, err)
+	require.Equal(t, sourceRepoID, initialization.sourceRepoID)
+	require.Equal(t, "", initialization.sourceProjectID)
+	require.True(t, initialization.defaultBranchOnly)
+}
+
+// verifies that a fork references the source repository and, if only the default branch is forked, passes the
+// default branch of the source repository as source ref
+func TestAzureGitRepo_Create_ForksSourceRepository(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	sourceRepoID := uuid.New()
+	sourceProjectID := uuid.New()
+	resourceData := schema.TestResourceDataRaw(t, resourceAzureGitRepository().Schema, nil)
+	flattenAzureGitRepository(resourceData, &testAzureGitRepository)
+	resourceData.Set("initialization", &[]map[string]interface{}{
+		{
+			"init_type":            "Fork",
+			"source_repository_id": sourceRepoID.String(),
+			"source_project_id":    sourceProjectID.String(),
+			"default_branch_only":  true,
+		},
+	})
+
+	reposClient := azdosdkmocks.NewMockGitClient(ctrl)
+	clients := &config.AggregatedClient{GitReposClient: reposClient, Ctx: context.Background()}
+
+	reposClient.
+		EXPECT().
+		GetRepository(clients.Ctx, git.GetRepositoryArgs{
+			RepositoryId: converter.String(sourceRepoID.String()),
+			Project:      converter.String(sourceProjectID.String()),
+		}).
+		Return(&git.GitRepository{Id: &sourceRepoID, DefaultBranch: converter.String("refs/heads/main")}, nil).
+		Times(1)
+	reposClient.
+		EXPECT().
+		CreateRepository(clients.Ctx, git.CreateRepositoryArgs{
+			GitRepositoryToCreate: &git.GitRepositoryCreateOptions{
+				Name: testAzureGitRepository.Name,
+				Project: &core.TeamProjectReference{
+					Id: &testRepoProjectID,
+				},
+				ParentRepository: &git.GitRepositoryRef{
+					Id: &sourceRepoID,
+					Project: &core.TeamProjectReference{
+						Id: &sourceProjectID,
+					},
+				},
+			},
+			SourceRef: converter.String("refs/heads/main"),
+		}).
+		Return(nil, errors.New("CreateAzureGitRepository() Failed")).
+		Times(1)
+
+	err := resourceAzureGitRepositoryCreate(resourceData, clients)
+	require.Regexp(t, ".*CreateAzureGitRepository\\(\\) Failed$", err.Error())
+}
+
+// verifies that the initial commit is pushed to the configured branch and adds the configured files
+func TestAzureGitRepo_Initialize_PushesConfiguredFiles(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	sourceFile, err := ioutil.TempFile("", "seed")
+	require.Nil(t, err)
+	defer os.Remove(sourceFile.Name())
+	_, err = sourceFile.Write([]byte{0, 1, 2})
+	require.Nil(t, err)
+	sourceFile.Close()
+
+	resourceData := schema.TestResourceDataRaw(t, resourceAzureGitRepository().Schema, nil)
+	flattenAzureGitRepository(resourceData, &testAzureGitRepository)
+	resourceData.Set("initialization", &[]map[string]interface{}{
+		{
+			"init_type":      "Clean",
+			"initial_branch": "main",
+			"commit_message": "Add seed files",
+			"file": []interface{}{
+				map[string]interface{}{"path": "README.md", "content": "# Readme"},
+				map[string]interface{}{"path": "/bin/seed", "source": sourceFile.Name()},
+			},
+		},
+	})
+	_, initialization, _, err := expandAzureGitRepository(resourceData)
+	require.Nil(t, err)
+
+	reposClient := azdosdkmocks.NewMockGitClient(ctrl)
+	clients := &config.AggregatedClient{GitReposClient: reposClient, Ctx: context.Background()}
+
+	reposClient.
+		EXPECT().
+		CreatePush(clients.Ctx, gomock.Any()).
+		DoAndReturn(func(ctx context.Context, args git.CreatePushArgs) (*git.GitPush, error) {
+			require.Equal(t, "refs/heads/main", *(*args.Push.RefUpdates)[0].Name)
+			commit := (*args.Push.Commits)[0]
+			require.Equal(t, "Add seed files", *commit.Comment)
+			require.Equal(t, []interface{}{
+				git.Change{
+					ChangeType: &git.VersionControlChangeTypeValues.Add,
+					Item:       git.GitItem{Path: converter.String("/README.md")},
+					NewContent: &git.ItemContent{ContentType: &git.ItemContentTypeValues.RawText, Content: converter.String("# Readme")},
+				},
+				git.Change{
+					ChangeType: &git.VersionControlChangeTypeValues.Add,
+					Item:       git.GitItem{Path: converter.String("/bin/seed")},
+					NewContent: &git.ItemContent{ContentType: &git.ItemContentTypeValues.Base64Encoded, Content: converter.String("AAEC")},
+				},
+			}, *commit.Changes)
+			return nil, nil
+		}).
+		Times(1)
+
+	err = initializeAzureGitRepository(clients, &testAzureGitRepository, initialization)
+	require.Nil(t, err)
+}
+
+// verifies that files can only be added to clean repositories, either inline or from a local file
+func TestAzureGitRepo_Expand_ValidatesInitializationFiles(t *testing.T) {
+	resourceData := schema.TestResourceDataRaw(t, resourceAzureGitRepository().Schema, nil)
+	flattenAzureGitRepository(resourceData, &testAzureGitRepository)
+
+	resourceData.Set("initialization", &[]map[string]interface{}{
+		{"init_type": "Uninitialized", "file": []interface{}{map[string]interface{}{"path": "README.md", "content": "# Readme"}}},
+	})
+	_, _, _, err := expandAzureGitRepository(resourceData)
+	require.Equal(t, "Files can only be added to repositories with init_type Clean", err.Error())
+
+	resourceData.Set("initialization", &[]map[string]interface{}{
+		{"init_type": "Clean", "file": []interface{}{map[string]interface{}{"path": "README.md", "content": "# Readme", "source": "README.md"}}},
+	})
+	_, _, _, err = expandAzureGitRepository(resourceData)
+	require.Equal(t, "Either content or source can be set for file README.md, not both", err.Error())
+}
+
+// verifies that the default branch is only changed to branches that exist
+func TestAzureGitRepo_Update_ValidatesDefaultBranchExists(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	reposClient := azdosdkmocks.NewMockGitClient(ctrl)
+	clients := &config.AggregatedClient{GitReposClient: reposClient, Ctx: context.Background()}
+
+	reposClient.
+		EXPECT().
+		GetRefs(clients.Ctx, git.GetRefsArgs{
+			RepositoryId: converter.String(testRepoID.String()),
+			Project:      converter.String(testRepoProjectID.String()),
+			Filter:       converter.String("heads/main"),
+		}).
+		Return(&git.GetRefsResponseValue{Value: []git.GitRef{{Name: converter.String("refs/heads/maintenance")}}}, nil).
+		Times(1)
+
+	repo := &git.GitRepository{Id: &testRepoID}
+	err := setAzureGitRepositoryDefaultBranch(clients, repo, &testRepoProjectID, "main")
+	require.Equal(t, fmt.Sprintf("Branch refs/heads/main does not exist in repository %s and cannot be the default branch", testRepoID.String()), err.Error())
+	require.Nil(t, repo.DefaultBranch)
+
+	reposClient.
+		EXPECT().
+		GetRefs(clients.Ctx, gomock.Any()).
+		Return(&git.GetRefsResponseValue{Value: []git.GitRef{{Name: converter.String("refs/heads/main")}}}, nil).
+		Times(1)
+
+	err = setAzureGitRepositoryDefaultBranch(clients, repo, &testRepoProjectID, "refs/heads/main")
+	require.Nil(t, err)
+	require.Equal(t, "refs/heads/main", *repo.DefaultBranch)
+}
+
+// verifies that imports require a Git source URL and at most one way of authentication
+func TestAzureGitRepo_Expand_ValidatesImportInitialization(t *testing.T) {
+	resourceData := schema.TestResourceDataRaw(t, resourceAzureGitRepository().Schema, nil)
+	flattenAzureGitRepository(resourceData, &testAzureGitRepository)
+
+	invalidInitializations := []map[string]interface{}{
+		{"init_type": "Import"},
+		{"init_type": "Import", "source_type": "Tfvc", "source_url": "https://example.com/repo.git"},
+		{"init_type": "Import", "source_url": "https://example.com/repo.git", "service_endpoint_id": uuid.New().String(), "username": "user"},
+		{"init_type": "Import", "source_url": "https://example.com/repo.git", "password": "secret"},
+	}
+	for _, initialization := range invalidInitializations {
+		resourceData.Set("initialization", &[]map[string]interface{}{initialization})
+		_, _, _, err := expandAzureGitRepository(resourceData)
+		require.NotNil(t, err)
+	}
+
+	resourceData.Set("initialization", &[]map[string]interface{}{
+		{"init_type": "Import", "source_type": "Git", "source_url": "https://example.com/repo.git", "username": "user", "password": "secret"},
+	})
+	_, initialization, _, err := expandAzureGitRepository(resourceData)
+	require.Nil(t, err)
+	require.Equal(t, "https://example.com/repo.git", initialization.sourceURL)
+	require.Equal(t, "user", initialization.username)
+	require.Equal(t, "secret", initialization.password)
+}
+
+// verifies that the service endpoint created for an import with username and password is removed if the import cannot be requested
+func TestAzureGitRepo_Import_RemovesServiceEndpointIfImportRequestFails(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	reposClient := azdosdkmocks.NewMockGitClient(ctrl)
+	serviceEndpointClient := azdosdkmocks.NewMockServiceendpointClient(ctrl)
+	clients := &config.AggregatedClient{
+		GitReposClient:        reposClient,
+		ServiceEndpointClient: serviceEndpointClient,
+		Ctx:                   context.Background(),
+	}
+
+	serviceEndpointID := uuid.New()
+	serviceEndpointClient.
+		EXPECT().
+		CreateServiceEndpoint(clients.Ctx, gomock.Any()).
+		DoAndReturn(func(ctx context.Context, args serviceendpoint.CreateServiceEndpointArgs) (*serviceendpoint.ServiceEndpoint, error) {
+			require.Equal(t, "UsernamePassword", *args.Endpoint.Authorization.Scheme)
+			require.Equal(t, "secret", (*args.Endpoint.Authorization.Parameters)["password"])
+			return &serviceendpoint.ServiceEndpoint{Id: &serviceEndpointID}, nil
+		}).
+		Times(1)
+	reposClient.
+		EXPECT().
+		CreateImportRequest(clients.Ctx, gomock.Any()).
+		DoAndReturn(func(ctx context.Context, args git.CreateImportRequestArgs) (*git.GitImportRequest, error) {
+			require.Equal(t, serviceEndpointID, *args.ImportRequest.Parameters.ServiceEndpointId)
+			require.True(t, *args.ImportRequest.Parameters.DeleteServiceEndpointAfterImportIsDone)
+			return nil, errors.New("CreateImportRequest() Failed")
+		}).
+		Times(1)
+	serviceEndpointClient.
+		EXPECT().
+		DeleteServiceEndpoint(clients.Ctx, serviceendpoint.DeleteServiceEndpointArgs{
+			Project:    converter.String(testRepoProjectID.String()),
+			EndpointId: &serviceEndpointID,
+		}).
+		Return(nil).
+		Times(1)
+
+	err := importAzureGitRepository(clients, &testAzureGitRepository, &repoInitializationMeta{
+		initType:  "Import",
+		sourceURL: "https://example.com/repo.git",
+		username:  "user",
+		password:  "secret",
+	}, time.Minute)
+	require.Contains(t, err.Error(), "CreateImportRequest() Failed")
+}
+
+// verifies that a failed import is reported with the error message of the service
+func TestAzureGitRepo_Import_ReportsFailedImport(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	reposClient := azdosdkmocks.NewMockGitClient(ctrl)
+	clients := &config.AggregatedClient{GitReposClient: reposClient, Ctx: context.Background()}
+
+	pollInterval := gitImportPollInterval
+	gitImportPollInterval = time.Millisecond
+	defer func() { gitImportPollInterval = pollInterval }()
+
+	reposClient.
+		EXPECT().
+		CreateImportRequest(clients.Ctx, git.CreateImportRequestArgs{
+			ImportRequest: &git.GitImportRequest{
+				Parameters: &git.GitImportRequestParameters{
+					GitSource: &git.GitImportGitSource{Url: converter.String("https://example.com/repo.git")},
+				},
+			},
+			Project:      converter.String(testRepoProjectID.String()),
+			RepositoryId: converter.String(testRepoID.String()),
+		}).
+		Return(&git.GitImportRequest{ImportRequestId: converter.Int(7)}, nil).
+		Times(1)
+	reposClient.
+		EXPECT().
+		GetImportRequest(clients.Ctx, git.GetImportRequestArgs{
+			Project:         converter.String(testRepoProjectID.String()),
+			RepositoryId:    converter.String(testRepoID.String()),
+			ImportRequestId: converter.Int(7),
+		}).
+		Return(&git.GitImportRequest{
+			Status:         &git.GitAsyncOperationStatusValues.Failed,
+			DetailedStatus: &git.GitImportStatusDetail{ErrorMessage: converter.String("Authentication failed")},
+		}, nil).
+		Times(1)
+
+	err := importAzureGitRepository(clients, &testAzureGitRepository, &repoInitializationMeta{
+		initType:  "Import",
+		sourceURL: "https://example.com/repo.git",
+	}, time.Minute)
+	require.Equal(t, "Import failed: Authentication failed", err.Error())
+}
+
+// verifies that the import fails instead of panicking if the status of the import request is not returned
+func TestAzureGitRepo_Import_FailsWithoutImportStatus(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	reposClient := azdosdkmocks.NewMockGitClient(ctrl)
+	clients := &config.AggregatedClient{GitReposClient: reposClient, Ctx: context.Background()}
+
+	pollInterval := gitImportPollInterval
+	gitImportPollInterval = time.Millisecond
+	defer func() { gitImportPollInterval = pollInterval }()
+
+	reposClient.
+		EXPECT().
+		CreateImportRequest(clients.Ctx, gomock.Any()).
+		Return(&git.GitImportRequest{ImportRequestId: converter.Int(7)}, nil).
+		Times(1)
+	reposClient.
+		EXPECT().
+		GetImportRequest(clients.Ctx, gomock.Any()).
+		Return(nil, nil).
+		Times(1)
+
+	err := importAzureGitRepository(clients, &testAzureGitRepository, &repoInitializationMeta{
+		initType:  "Import",
+		sourceURL: "https://example.com/repo.git",
+	}, time.Minute)
+	require.Equal(t, "Status of import request 7 is not available", err.Error())
+}
+
+// verifies that the time to wait for an import is limited by the create timeout, which defaults to 30 minutes
+func TestAzureGitRepo_Timeouts_DefaultCreateTimeout(t *testing.T) {
+	resourceData := resourceAzureGitRepository().Data(nil)
+	require.Equal(t, 30*time.Minute, resourceData.Timeout(schema.TimeoutCreate))
+}
+
 // verifies that the read operation is considered failed if the initial API
 // call fails.
 func TestAzureGitRepo_Read_DoesNotSwallowErrorFromFailedReadCall(t *testing.T) {
@@ -241,6 +593,312 @@ func TestAzureGitRepo_Read_UsesNameIfIdNotSet(t *testing.T) {
 	resourceAzureGitRepositoryRead(resourceData, clients)
 }
 
+// testGitRepositoryStateClient replaces the calls of the repositories API that are not part of the SDK
+type testGitRepositoryStateClient struct {
+	disabled bool
+	setCalls int
+}
+
+func (c *testGitRepositoryStateClient) GetDisabled(clients *config.AggregatedClient, repoID string) (bool, error) {
+	return c.disabled, nil
+}
+
+func (c *testGitRepositoryStateClient) SetDisabled(clients *config.AggregatedClient, repoID string, disabled bool) error {
+	c.setCalls++
+	c.disabled = disabled
+	return nil
+}
+
+// verifies that the disabled state and the settings are read, and that only the settings policy scoped to the
+// repository alone is used
+func TestAzureGitRepo_Read_ReadsStateAndSettings(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	stateClient := &testGitRepositoryStateClient{disabled: true}
+	previousStateClient := azureGitRepositoryStateClient
+	azureGitRepositoryStateClient = stateClient
+	defer func() { azureGitRepositoryStateClient = previousStateClient }()
+
+	reposClient := azdosdkmocks.NewMockGitClient(ctrl)
+	policyClient := azdosdkmocks.NewMockPolicyClient(ctrl)
+	clients := &config.AggregatedClient{GitReposClient: reposClient, PolicyClient: policyClient, Ctx: context.Background()}
+
+	resourceData := schema.TestResourceDataRaw(t, resourceAzureGitRepository().Schema, nil)
+	resourceData.SetId(testRepoID.String())
+	resourceData.Set("project_id", testRepoProjectID.String())
+
+	reposClient.
+		EXPECT().
+		GetRepository(clients.Ctx, gomock.Any()).
+		Return(&testAzureGitRepository, nil).
+		Times(1)
+
+	// settings as they are decoded from the service response
+	multiRepoPolicy := policy.PolicyConfiguration{
+		Id: converter.Int(1),
+		Settings: map[string]interface{}{
+			"allowedForkTargets": float64(1),
+			"scope": []interface{}{
+				map[string]interface{}{"repositoryId": testRepoID.String()},
+				map[string]interface{}{"repositoryId": uuid.New().String()},
+			},
+		},
+	}
+	projectPolicy := policy.PolicyConfiguration{
+		Id: converter.Int(2),
+		Settings: map[string]interface{}{
+			"allowedForkTargets": float64(1),
+			"scope":              []interface{}{map[string]interface{}{"repositoryId": nil}},
+		},
+	}
+	repoPolicy := policy.PolicyConfiguration{
+		Id: converter.Int(3),
+		Settings: map[string]interface{}{
+			"allowedForkTargets": float64(0),
+			"gvfsOnly":           true,
+			"scope":              []interface{}{map[string]interface{}{"repositoryId": testRepoID.String()}},
+		},
+	}
+	policyClient.
+		EXPECT().
+		GetPolicyConfigurations(clients.Ctx, gomock.Any()).
+		Return(&policy.GetPolicyConfigurationsResponseValue{Value: []policy.PolicyConfiguration{multiRepoPolicy, projectPolicy, repoPolicy}}, nil).
+		Times(1)
+
+	err := resourceAzureGitRepositoryRead(resourceData, clients)
+	require.Nil(t, err)
+	require.Equal(t, true, resourceData.Get("disabled"))
+	require.Equal(t, false, resourceData.Get("forks_allowed"))
+	require.Equal(t, true, resourceData.Get("gvfs_only"))
+}
+
+// verifies that only the settings are changed for a repository that stays disabled
+func TestAzureGitRepo_Update_SkipsRepositoryWhileDisabled(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	stateClient := &testGitRepositoryStateClient{disabled: true}
+	previousStateClient := azureGitRepositoryStateClient
+	azureGitRepositoryStateClient = stateClient
+	defer func() { azureGitRepositoryStateClient = previousStateClient }()
+
+	reposClient := azdosdkmocks.NewMockGitClient(ctrl)
+	policyClient := azdosdkmocks.NewMockPolicyClient(ctrl)
+	clients := &config.AggregatedClient{GitReposClient: reposClient, PolicyClient: policyClient, Ctx: context.Background()}
+
+	r := resourceAzureGitRepository()
+	repoConfig := map[string]interface{}{
+		"project_id":     testRepoProjectID.String(),
+		"name":           *testAzureGitRepository.Name,
+		"disabled":       true,
+		"initialization": []interface{}{map[string]interface{}{"init_type": "Clean"}},
+	}
+	stateData := schema.TestResourceDataRaw(t, r.Schema, repoConfig)
+	stateData.SetId(testRepoID.String())
+	state := stateData.State()
+
+	repoConfig["forks_allowed"] = false
+	diff, err := r.Diff(state, terraform.NewResourceConfigRaw(repoConfig), nil)
+	require.Nil(t, err)
+	resourceData, err := schema.InternalMap(r.Schema).Data(state, diff)
+	require.Nil(t, err)
+
+	policyClient.
+		EXPECT().
+		GetPolicyConfigurations(clients.Ctx, gomock.Any()).
+		Return(&policy.GetPolicyConfigurationsResponseValue{}, nil).
+		Times(2)
+	policyClient.
+		EXPECT().
+		CreatePolicyConfiguration(clients.Ctx, gomock.Any()).
+		DoAndReturn(func(ctx context.Context, args policy.CreatePolicyConfigurationArgs) (*policy.PolicyConfiguration, error) {
+			settings := args.Configuration.Settings.(map[string]interface{})
+			require.Equal(t, 0, settings["allowedForkTargets"])
+			return args.Configuration, nil
+		}).
+		Times(1)
+	reposClient.
+		EXPECT().
+		GetRepository(clients.Ctx, gomock.Any()).
+		Return(&testAzureGitRepository, nil).
+		Times(1)
+
+	err = resourceAzureGitRepositoryUpdate(resourceData, clients)
+	require.Nil(t, err)
+	require.Equal(t, 0, stateClient.setCalls)
+	require.Equal(t, true, resourceData.Get("disabled"))
+}
+
+// verifies that forks and GVFS-only mode are added to an existing settings policy without dropping its settings
+func TestAzureGitRepo_SetSettings_KeepsExistingPolicySettings(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	policyClient := azdosdkmocks.NewMockPolicyClient(ctrl)
+	clients := &config.AggregatedClient{PolicyClient: policyClient, Ctx: context.Background()}
+
+	otherRepoPolicy := policy.PolicyConfiguration{
+		Id: converter.Int(1),
+		Settings: map[string]interface{}{
+			"scope": []interface{}{map[string]interface{}{"repositoryId": uuid.New().String()}},
+		},
+	}
+	repoPolicy := policy.PolicyConfiguration{
+		Id: converter.Int(2),
+		Settings: map[string]interface{}{
+			"enforceConsistentCase": true,
+			"scope":                 []interface{}{map[string]interface{}{"repositoryId": testRepoID.String()}},
+		},
+	}
+	policyClient.
+		EXPECT().
+		GetPolicyConfigurations(clients.Ctx, policy.GetPolicyConfigurationsArgs{
+			Project:    converter.String(testRepoProjectID.String()),
+			PolicyType: &gitRepositorySettingsPolicyType,
+		}).
+		Return(&policy.GetPolicyConfigurationsResponseValue{Value: []policy.PolicyConfiguration{otherRepoPolicy, repoPolicy}}, nil).
+		Times(1)
+	policyClient.
+		EXPECT().
+		UpdatePolicyConfiguration(clients.Ctx, gomock.Any()).
+		DoAndReturn(func(ctx context.Context, args policy.UpdatePolicyConfigurationArgs) (*policy.PolicyConfiguration, error) {
+			require.Equal(t, 2, *args.ConfigurationId)
+
+			settings := args.Configuration.Settings.(map[string]interface{})
+			require.Equal(t, true, settings["enforceConsistentCase"])
+			require.Equal(t, 0, settings["allowedForkTargets"])
+			require.Equal(t, true, settings["gvfsOnly"])
+			return args.Configuration, nil
+		}).
+		Times(1)
+
+	err := setAzureGitRepositorySettings(clients, testRepoProjectID.String(), testRepoID.String(), false, true)
+	require.Nil(t, err)
+}
+
+// verifies that the repository resource and the case enforcement resource share the settings policy of a repository
+// without dropping the settings of each other
+func TestAzureGitRepo_Settings_SharedWithCaseEnforcement(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	policyClient := azdosdkmocks.NewMockPolicyClient(ctrl)
+	clients := &config.AggregatedClient{PolicyClient: policyClient, Ctx: context.Background()}
+
+	// the single settings policy of the service, which is encoded and decoded like the settings of a service response
+	var storedPolicy []byte
+	createCalls := 0
+	loadPolicy := func() *policy.PolicyConfiguration {
+		if storedPolicy == nil {
+			return nil
+		}
+		var configuration policy.PolicyConfiguration
+		require.Nil(t, json.Unmarshal(storedPolicy, &configuration))
+		return &configuration
+	}
+	savePolicy := func(configuration *policy.PolicyConfiguration) *policy.PolicyConfiguration {
+		var err error
+		storedPolicy, err = json.Marshal(configuration)
+		require.Nil(t, err)
+		return loadPolicy()
+	}
+
+	policyClient.
+		EXPECT().
+		GetPolicyConfigurations(clients.Ctx, gomock.Any()).
+		DoAndReturn(func(ctx context.Context, args policy.GetPolicyConfigurationsArgs) (*policy.GetPolicyConfigurationsResponseValue, error) {
+			configurations := []policy.PolicyConfiguration{}
+			if configuration := loadPolicy(); configuration != nil {
+				configurations = append(configurations, *configuration)
+			}
+			return &policy.GetPolicyConfigurationsResponseValue{Value: configurations}, nil
+		}).
+		AnyTimes()
+	policyClient.
+		EXPECT().
+		GetPolicyConfiguration(clients.Ctx, gomock.Any()).
+		DoAndReturn(func(ctx context.Context, args policy.GetPolicyConfigurationArgs) (*policy.PolicyConfiguration, error) {
+			return loadPolicy(), nil
+		}).
+		AnyTimes()
+	policyClient.
+		EXPECT().
+		CreatePolicyConfiguration(clients.Ctx, gomock.Any()).
+		DoAndReturn(func(ctx context.Context, args policy.CreatePolicyConfigurationArgs) (*policy.PolicyConfiguration, error) {
+			createCalls++
+			args.Configuration.Id = converter.Int(1)
+			return savePolicy(args.Configuration), nil
+		}).
+		AnyTimes()
+	policyClient.
+		EXPECT().
+		UpdatePolicyConfiguration(clients.Ctx, gomock.Any()).
+		DoAndReturn(func(ctx context.Context, args policy.UpdatePolicyConfigurationArgs) (*policy.PolicyConfiguration, error) {
+			require.Equal(t, 1, *args.ConfigurationId)
+			return savePolicy(args.Configuration), nil
+		}).
+		AnyTimes()
+	policyClient.
+		EXPECT().
+		DeletePolicyConfiguration(gomock.Any(), gomock.Any()).
+		Times(0)
+
+	projectID := testRepoProjectID.String()
+	repoID := testRepoID.String()
+	caseEnforcement := resourceRepositoryPolicyCaseEnforcement()
+	resourceData := schema.TestResourceDataRaw(t, caseEnforcement.Schema, nil)
+	resourceData.Set("project_id", projectID)
+	resourceData.Set("settings", []interface{}{map[string]interface{}{
+		"enforce_consistent_case": true,
+		"scope": []interface{}{
+			map[string]interface{}{"repository_id": repoID},
+		},
+	}})
+
+	require.Nil(t, setAzureGitRepositorySettings(clients, projectID, repoID, false, true))
+	require.Nil(t, caseEnforcement.Create(resourceData, clients))
+	require.Equal(t, "1", resourceData.Id())
+	require.Equal(t, 1, createCalls)
+
+	require.Nil(t, setAzureGitRepositorySettings(clients, projectID, repoID, true, false))
+	require.Equal(t, true, loadPolicy().Settings.(map[string]interface{})["enforceConsistentCase"])
+
+	resourceData.Set("settings.0.enforce_consistent_case", false)
+	require.Nil(t, caseEnforcement.Update(resourceData, clients))
+	forksAllowed, gvfsOnly, err := getAzureGitRepositorySettings(clients, projectID, repoID)
+	require.Nil(t, err)
+	require.True(t, forksAllowed)
+	require.False(t, gvfsOnly)
+
+	require.Nil(t, caseEnforcement.Delete(resourceData, clients))
+	require.NotContains(t, loadPolicy().Settings.(map[string]interface{}), "enforceConsistentCase")
+	forksAllowed, gvfsOnly, err = getAzureGitRepositorySettings(clients, projectID, repoID)
+	require.Nil(t, err)
+	require.True(t, forksAllowed)
+	require.False(t, gvfsOnly)
+}
+
+// verifies that the service defaults are used if the repository does not have a settings policy
+func TestAzureGitRepo_GetSettings_DefaultsWithoutPolicy(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	policyClient := azdosdkmocks.NewMockPolicyClient(ctrl)
+	clients := &config.AggregatedClient{PolicyClient: policyClient, Ctx: context.Background()}
+
+	policyClient.
+		EXPECT().
+		GetPolicyConfigurations(clients.Ctx, gomock.Any()).
+		Return(&policy.GetPolicyConfigurationsResponseValue{}, nil).
+		Times(1)
+
+	forksAllowed, gvfsOnly, err := getAzureGitRepositorySettings(clients, testRepoProjectID.String(), testRepoID.String())
+	require.Nil(t, err)
+	require.True(t, forksAllowed)
+	require.False(t, gvfsOnly)
+}
+
 /**
  * Begin acceptance tests
  */
@@ -294,6 +952,42 @@ func TestAccAzureGitRepo_CreateAndUpdate(t *testing.T) {
 	})
 }
 
+// Verifies that forks can be disallowed and that a repository can be disabled and enabled again
+func TestAccAzureGitRepo_Settings_CreateAndUpdate(t *testing.T) {
+	projectName := testhelper.TestAccResourcePrefix + acctest.RandStringFromCharSet(10, acctest.CharSetAlphaNum)
+	gitRepoName := testhelper.TestAccResourcePrefix + acctest.RandStringFromCharSet(10, acctest.CharSetAlphaNum)
+	tfRepoNode := "azuredevops_azure_git_repository.gitrepo"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testhelper.TestAccPreCheck(t, nil) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccAzureGitRepoCheckDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testhelper.TestAccAzureGitRepoSettingsResource(projectName, gitRepoName, false, false),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(tfRepoNode, "forks_allowed", "false"),
+					resource.TestCheckResourceAttr(tfRepoNode, "gvfs_only", "false"),
+					resource.TestCheckResourceAttr(tfRepoNode, "disabled", "false"),
+				),
+			},
+			{
+				Config: testhelper.TestAccAzureGitRepoSettingsResource(projectName, gitRepoName, true, true),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(tfRepoNode, "forks_allowed", "true"),
+					resource.TestCheckResourceAttr(tfRepoNode, "disabled", "true"),
+				),
+			},
+			{
+				Config: testhelper.TestAccAzureGitRepoSettingsResource(projectName, gitRepoName, true, false),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(tfRepoNode, "disabled", "false"),
+				),
+			},
+		},
+	})
+}
+
 // Given the name of an AzDO git repository, this will return a function that will check whether
 // or not the definition (1) exists in the state and (2) exist in AzDO and (3) has the correct name
 func testAccCheckAzureGitRepoResourceExists(expectedName string) resource.TestCheckFunc {
@@ -321,27 +1015,6 @@ func testAccCheckAzureGitRepoResourceExists(expectedName string) resource.TestCh
 	}
 }
 
-func testAccAzureGitRepoCheckDestroy(s *terraform.State) error {
-	clients := testAccProvider.Meta().(*config.AggregatedClient)
-
-	// verify that every repository referenced in the state does not exist in AzDO
-	for _, resource := range s.RootModule().Resources {
-		if resource.Type != "azuredevops_azure_git_repository" {
-			continue
-		}
-
-		repoID := resource.Primary.ID
-		projectID := resource.Primary.Attributes["project_id"]
-
-		// indicates the git repository still exists - this should fail the test
-		if _, err := azureGitRepositoryRead(clients, repoID, "", projectID); err == nil {
-			return fmt.Errorf("repository with ID %s should not exist", repoID)
-		}
-	}
-
-	return nil
-}
-
 // Verifies that a newly created repo with init_type of "Clean" has the expected
 // master branch available
 func TestAccAzureGitRepo_RepoInitialization_Clean(t *testing.T) {
@@ -392,6 +1065,111 @@ func TestAccAzureGitRepo_RepoInitialization_Uninitialized(t *testing.T) {
 	})
 }
 
+// Verifies that a repository can be initialized on a custom branch and that the default branch can be changed
+func TestAccAzureGitRepo_RepoInitialization_CustomBranch(t *testing.T) {
+	projectName := testhelper.TestAccResourcePrefix + acctest.RandStringFromCharSet(10, acctest.CharSetAlphaNum)
+	gitRepoName := testhelper.TestAccResourcePrefix + acctest.RandStringFromCharSet(10, acctest.CharSetAlphaNum)
+	tfRepoNode := "azuredevops_azure_git_repository.gitrepo"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testhelper.TestAccPreCheck(t, nil) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccAzureGitRepoCheckDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testhelper.TestAccAzureGitRepoCustomInitializationResource(projectName, gitRepoName, "refs/heads/main"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAzureGitRepoResourceExists(gitRepoName),
+					resource.TestCheckResourceAttr(tfRepoNode, "default_branch", "refs/heads/main"),
+				),
+			},
+			{
+				Config:      testhelper.TestAccAzureGitRepoCustomInitializationResource(projectName, gitRepoName, "refs/heads/missing"),
+				ExpectError: regexp.MustCompile("Branch refs/heads/missing does not exist"),
+			},
+		},
+	})
+}
+
+// Verifies that a repository can be forked and is flagged as a fork
+func TestAccAzureGitRepo_RepoInitialization_Fork(t *testing.T) {
+	projectName := testhelper.TestAccResourcePrefix + acctest.RandStringFromCharSet(10, acctest.CharSetAlphaNum)
+	gitRepoName := testhelper.TestAccResourcePrefix + acctest.RandStringFromCharSet(10, acctest.CharSetAlphaNum)
+	forkRepoName := testhelper.TestAccResourcePrefix + acctest.RandStringFromCharSet(10, acctest.CharSetAlphaNum)
+	tfForkNode := "azuredevops_azure_git_repository.fork"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testhelper.TestAccPreCheck(t, nil) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccAzureGitRepoCheckDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testhelper.TestAccAzureGitRepoForkResource(projectName, gitRepoName, forkRepoName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(tfForkNode, "name", forkRepoName),
+					testAccCheckAzureGitRepoResourceExists(gitRepoName),
+					resource.TestCheckResourceAttr(tfForkNode, "is_fork", "true"),
+					testAccCheckAzureGitRepoForkParent(tfForkNode, "azuredevops_azure_git_repository.gitrepo"),
+				),
+			},
+		},
+	})
+}
+
+// Given the resource names of a fork and its source repository, this will verify that the parent repository of the
+// fork is the source repository
+func testAccCheckAzureGitRepoForkParent(forkNode string, sourceNode string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		clients := testAccProvider.Meta().(*config.AggregatedClient)
+
+		forkRepo, ok := s.RootModule().Resources[forkNode]
+		if !ok {
+			return fmt.Errorf("Did not find %s in the TF state", forkNode)
+		}
+		sourceRepo, ok := s.RootModule().Resources[sourceNode]
+		if !ok {
+			return fmt.Errorf("Did not find %s in the TF state", sourceNode)
+		}
+
+		repo, err := azureGitRepositoryRead(clients, forkRepo.Primary.ID, "", forkRepo.Primary.Attributes["project_id"])
+		if err != nil {
+			return err
+		}
+
+		if repo.ParentRepository == nil || repo.ParentRepository.Id == nil {
+			return fmt.Errorf("AzDO Git Repository %s has no parent repository", forkRepo.Primary.ID)
+		}
+		if repo.ParentRepository.Id.String() != sourceRepo.Primary.ID {
+			return fmt.Errorf("AzDO Git Repository has parent repository %s, but expected %s", repo.ParentRepository.Id.String(), sourceRepo.Primary.ID)
+		}
+
+		return nil
+	}
+}
+
+// Verifies that a public repository can be imported
+func TestAccAzureGitRepo_RepoInitialization_Import(t *testing.T) {
+	projectName := testhelper.TestAccResourcePrefix + acctest.RandStringFromCharSet(10, acctest.CharSetAlphaNum)
+	gitRepoName := testhelper.TestAccResourcePrefix + acctest.RandStringFromCharSet(10, acctest.CharSetAlphaNum)
+	tfRepoNode := "azuredevops_azure_git_repository.gitrepo"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testhelper.TestAccPreCheck(t, nil) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccAzureGitRepoCheckDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testhelper.TestAccAzureGitRepoImportResource(projectName, gitRepoName, "https://github.com/microsoft/terraform-provider-azuredevops.git"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(tfRepoNode, "name", gitRepoName),
+					testAccCheckAzureGitRepoResourceExists(gitRepoName),
+					resource.TestCheckResourceAttr(tfRepoNode, "default_branch", "refs/heads/master"),
+				),
+			},
+		},
+	})
+}
+
 func init() {
 	InitProvider()
 }
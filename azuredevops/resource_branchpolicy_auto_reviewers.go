@@ -0,0 +1,140 @@
+package azuredevops
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/google/uuid"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/validation"
+	"github.com/microsoft/azure-devops-go-api/azuredevops/identity"
+	crud "github.com/microsoft/terraform-provider-azuredevops/azuredevops/crud/branchpolicy"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/utils/config"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/utils/converter"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/utils/validate"
+)
+
+var autoReviewersPolicyType = uuid.MustParse("fd2167ab-b0be-447a-8ec8-39368250530e")
+
+const (
+	autoReviewerIDs              = "auto_reviewer_ids"
+	autoReviewerPathFilters      = "path_filters"
+	autoReviewerMinimumReviewers = "minimum_number_of_reviewers"
+	autoReviewerSubmitterCanVote = "submitter_can_vote"
+	autoReviewerMessage          = "message"
+)
+
+// Reviewers are required if the policy is blocking and optional otherwise
+func resourceBranchPolicyAutoReviewers() *schema.Resource {
+	r := crud.GenBasePolicyResource(&crud.PolicyCrudArgs{
+		PolicyType:  autoReviewersPolicyType,
+		ExpandFunc:  expandAutoReviewersPolicySettings,
+		FlattenFunc: flattenAutoReviewersPolicySettings,
+	})
+
+	settings := crud.SettingsSchema(r)
+	settings[autoReviewerIDs] = &schema.Schema{
+		Type:     schema.TypeSet,
+		Required: true,
+		MinItems: 1,
+		Elem: &schema.Schema{
+			Type:         schema.TypeString,
+			ValidateFunc: validate.NoEmptyStrings,
+		},
+		Set: schema.HashString,
+	}
+	settings[autoReviewerPathFilters] = &schema.Schema{
+		Type:     schema.TypeList,
+		Optional: true,
+		Elem: &schema.Schema{
+			Type:         schema.TypeString,
+			ValidateFunc: validate.NoEmptyStrings,
+		},
+	}
+	settings[autoReviewerMinimumReviewers] = &schema.Schema{
+		Type:         schema.TypeInt,
+		Optional:     true,
+		Default:      1,
+		ValidateFunc: validation.IntAtLeast(1),
+	}
+	settings[autoReviewerSubmitterCanVote] = &schema.Schema{
+		Type:     schema.TypeBool,
+		Optional: true,
+		Default:  false,
+	}
+	settings[autoReviewerMessage] = &schema.Schema{
+		Type:     schema.TypeString,
+		Optional: true,
+	}
+	return r
+}
+
+// The reviewers are configured as subject descriptors, but the policy expects the IDs of their identities
+func expandAutoReviewersPolicySettings(clients *config.AggregatedClient, tfSettings map[string]interface{}, policySettings map[string]interface{}) error {
+	reviewerIDs := []string{}
+	for _, descriptor := range tfSettings[autoReviewerIDs].(*schema.Set).List() {
+		reviewerID, err := getPrincipalIdentityID(clients, descriptor.(string))
+		if err != nil {
+			return err
+		}
+		reviewerIDs = append(reviewerIDs, reviewerID)
+	}
+
+	pathFilters := []string{}
+	for _, filter := range tfSettings[autoReviewerPathFilters].([]interface{}) {
+		pathFilters = append(pathFilters, filter.(string))
+	}
+
+	policySettings["requiredReviewerIds"] = reviewerIDs
+	policySettings["filenamePatterns"] = pathFilters
+	policySettings["minimumApproverCount"] = tfSettings[autoReviewerMinimumReviewers].(int)
+	policySettings["creatorVoteCounts"] = tfSettings[autoReviewerSubmitterCanVote].(bool)
+	policySettings["message"] = tfSettings[autoReviewerMessage].(string)
+	return nil
+}
+
+func flattenAutoReviewersPolicySettings(clients *config.AggregatedClient, policySettings map[string]interface{}, tfSettings map[string]interface{}) error {
+	descriptors, err := getIdentitySubjectDescriptors(clients, crud.GetStrings(policySettings, "requiredReviewerIds"))
+	if err != nil {
+		return err
+	}
+
+	tfSettings[autoReviewerIDs] = descriptors
+	tfSettings[autoReviewerPathFilters] = crud.GetStrings(policySettings, "filenamePatterns")
+	tfSettings[autoReviewerMinimumReviewers] = crud.GetInt(policySettings, "minimumApproverCount")
+	tfSettings[autoReviewerSubmitterCanVote] = crud.GetBool(policySettings, "creatorVoteCounts")
+	tfSettings[autoReviewerMessage] = crud.GetString(policySettings, "message")
+	return nil
+}
+
+// Resolves the IDs of identities into their graph subject descriptors
+func getIdentitySubjectDescriptors(clients *config.AggregatedClient, identityIDs []string) ([]string, error) {
+	descriptors := []string{}
+	if len(identityIDs) == 0 {
+		return descriptors, nil
+	}
+
+	identities, err := clients.IdentityClient.ReadIdentities(clients.Ctx, identity.ReadIdentitiesArgs{
+		IdentityIds: converter.String(strings.Join(identityIDs, ",")),
+	})
+	if err != nil {
+		return nil, fmt.Errorf("Error resolving identities %s: %+v", strings.Join(identityIDs, ","), err)
+	}
+
+	resolved := map[string]string{}
+	if identities != nil {
+		for _, resolvedIdentity := range *identities {
+			if resolvedIdentity.Id != nil && resolvedIdentity.SubjectDescriptor != nil {
+				resolved[strings.ToLower(resolvedIdentity.Id.String())] = *resolvedIdentity.SubjectDescriptor
+			}
+		}
+	}
+	for _, identityID := range identityIDs {
+		descriptor, ok := resolved[strings.ToLower(identityID)]
+		if !ok {
+			return nil, fmt.Errorf("Could not resolve subject descriptor of identity %s", identityID)
+		}
+		descriptors = append(descriptors, descriptor)
+	}
+	return descriptors, nil
+}
@@ -0,0 +1,164 @@
+// +build all core resource_branchpolicy_auto_reviewers
+
+package azuredevops
+
+// The tests in this file use the mock clients in mock_client.go to mock out
+// the Azure DevOps client operations.
+
+import (
+	"context"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/google/uuid"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/microsoft/azure-devops-go-api/azuredevops/identity"
+	"github.com/microsoft/azure-devops-go-api/azuredevops/policy"
+	"github.com/microsoft/terraform-provider-azuredevops/azdosdkmocks"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/utils/config"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/utils/converter"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/utils/testhelper"
+	"github.com/stretchr/testify/require"
+)
+
+/**
+ * Begin unit tests
+ */
+
+// verifies that reviewer descriptors are sent as identity IDs and read back as descriptors
+func TestBranchPolicyAutoReviewers_Create_ResolvesReviewerIdentities(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	policyClient := azdosdkmocks.NewMockPolicyClient(ctrl)
+	identityClient := azdosdkmocks.NewMockIdentityClient(ctrl)
+	clients := &config.AggregatedClient{PolicyClient: policyClient, IdentityClient: identityClient, Ctx: context.Background()}
+
+	resourceData := schema.TestResourceDataRaw(t, resourceBranchPolicyAutoReviewers().Schema, nil)
+	resourceData.Set("project_id", testPolicyProjectID)
+	resourceData.Set("settings", []interface{}{map[string]interface{}{
+		"auto_reviewer_ids":           []interface{}{"vssgp.owners"},
+		"path_filters":                []interface{}{"/src/core/*"},
+		"minimum_number_of_reviewers": 1,
+		"message":                     "Owners were added",
+		"scope": []interface{}{map[string]interface{}{
+			"match_type": "DefaultBranch",
+		}},
+	}})
+
+	identityID := uuid.New()
+	identityClient.
+		EXPECT().
+		ReadIdentities(clients.Ctx, identity.ReadIdentitiesArgs{SubjectDescriptors: converter.String("vssgp.owners")}).
+		Return(&[]identity.Identity{{Id: &identityID}}, nil).
+		Times(1)
+	policyClient.
+		EXPECT().
+		CreatePolicyConfiguration(clients.Ctx, gomock.Any()).
+		DoAndReturn(func(ctx context.Context, args policy.CreatePolicyConfigurationArgs) (*policy.PolicyConfiguration, error) {
+			require.Equal(t, autoReviewersPolicyType, *args.Configuration.Type.Id)
+
+			settings := args.Configuration.Settings.(map[string]interface{})
+			require.Equal(t, []string{identityID.String()}, settings["requiredReviewerIds"])
+			require.Equal(t, []string{"/src/core/*"}, settings["filenamePatterns"])
+			require.Equal(t, 1, settings["minimumApproverCount"])
+			require.Equal(t, "Owners were added", settings["message"])
+
+			// settings as they are decoded from the service response
+			return &policy.PolicyConfiguration{
+				Id:         converter.Int(3),
+				IsEnabled:  args.Configuration.IsEnabled,
+				IsBlocking: args.Configuration.IsBlocking,
+				Type:       args.Configuration.Type,
+				Settings: map[string]interface{}{
+					"requiredReviewerIds":  []interface{}{identityID.String()},
+					"filenamePatterns":     []interface{}{"/src/core/*"},
+					"minimumApproverCount": float64(1),
+					"creatorVoteCounts":    false,
+					"message":              "Owners were added",
+					"scope": []interface{}{map[string]interface{}{
+						"repositoryId": nil,
+						"matchKind":    "DefaultBranch",
+					}},
+				},
+			}, nil
+		}).
+		Times(1)
+	identityClient.
+		EXPECT().
+		ReadIdentities(clients.Ctx, identity.ReadIdentitiesArgs{IdentityIds: converter.String(identityID.String())}).
+		Return(&[]identity.Identity{{Id: &identityID, SubjectDescriptor: converter.String("vssgp.owners")}}, nil).
+		Times(1)
+
+	err := resourceBranchPolicyAutoReviewers().Create(resourceData, clients)
+	require.Nil(t, err)
+	require.Equal(t, "3", resourceData.Id())
+	require.Equal(t, []interface{}{"vssgp.owners"}, resourceData.Get("settings.0.auto_reviewer_ids").(*schema.Set).List())
+}
+
+// verifies that reviewers that cannot be resolved into a descriptor are reported
+func TestBranchPolicyAutoReviewers_Flatten_ErrorsOnUnknownIdentity(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	identityClient := azdosdkmocks.NewMockIdentityClient(ctrl)
+	clients := &config.AggregatedClient{IdentityClient: identityClient, Ctx: context.Background()}
+
+	identityID := uuid.New().String()
+	identityClient.
+		EXPECT().
+		ReadIdentities(clients.Ctx, gomock.Any()).
+		Return(&[]identity.Identity{}, nil).
+		Times(1)
+
+	err := flattenAutoReviewersPolicySettings(clients, map[string]interface{}{
+		"requiredReviewerIds": []interface{}{identityID},
+	}, map[string]interface{}{})
+	require.NotNil(t, err)
+	require.Contains(t, err.Error(), identityID)
+}
+
+/**
+ * Begin acceptance tests
+ */
+
+// Verifies that an automatically included reviewers policy can be created, updated and imported
+func TestAccBranchPolicyAutoReviewers_CreateAndUpdate(t *testing.T) {
+	projectName := testhelper.TestAccResourcePrefix + acctest.RandStringFromCharSet(10, acctest.CharSetAlphaNum)
+	gitRepoName := testhelper.TestAccResourcePrefix + acctest.RandStringFromCharSet(10, acctest.CharSetAlphaNum)
+	tfPolicyNode := "azuredevops_branch_policy_auto_reviewers.policy"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testhelper.TestAccPreCheck(t, nil) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccBranchPolicyCheckDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testhelper.TestAccBranchPolicyAutoReviewersResource(projectName, gitRepoName, "/src/*"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(tfPolicyNode, "blocking", "false"),
+					resource.TestCheckResourceAttr(tfPolicyNode, "settings.0.auto_reviewer_ids.#", "1"),
+					resource.TestCheckResourceAttr(tfPolicyNode, "settings.0.path_filters.0", "/src/*"),
+				),
+			},
+			{
+				Config: testhelper.TestAccBranchPolicyAutoReviewersResource(projectName, gitRepoName, "/docs/*"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(tfPolicyNode, "settings.0.path_filters.0", "/docs/*"),
+				),
+			},
+			{
+				ResourceName:      tfPolicyNode,
+				ImportStateIdFunc: testAccBranchPolicyImportStateIDFunc(tfPolicyNode),
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func init() {
+	InitProvider()
+}
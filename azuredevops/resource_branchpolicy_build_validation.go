@@ -0,0 +1,93 @@
+package azuredevops
+
+import (
+	"github.com/google/uuid"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/validation"
+	crud "github.com/microsoft/terraform-provider-azuredevops/azuredevops/crud/branchpolicy"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/utils/config"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/utils/validate"
+)
+
+var buildValidationPolicyType = uuid.MustParse("0609b952-1397-4640-95ec-e00a01b2c241")
+
+const (
+	buildValidationDefinitionID            = "build_definition_id"
+	buildValidationDisplayName             = "display_name"
+	buildValidationFilenamePatterns        = "filename_patterns"
+	buildValidationManualQueueOnly         = "manual_queue_only"
+	buildValidationQueueOnSourceUpdateOnly = "queue_on_source_update_only"
+	buildValidationValidDuration           = "valid_duration"
+)
+
+func resourceBranchPolicyBuildValidation() *schema.Resource {
+	r := crud.GenBasePolicyResource(&crud.PolicyCrudArgs{
+		PolicyType:  buildValidationPolicyType,
+		ExpandFunc:  expandBuildValidationPolicySettings,
+		FlattenFunc: flattenBuildValidationPolicySettings,
+	})
+
+	settings := crud.SettingsSchema(r)
+	settings[buildValidationDefinitionID] = &schema.Schema{
+		Type:         schema.TypeInt,
+		Required:     true,
+		ValidateFunc: validation.IntAtLeast(1),
+	}
+	settings[buildValidationDisplayName] = &schema.Schema{
+		Type:         schema.TypeString,
+		Required:     true,
+		ValidateFunc: validate.NoEmptyStrings,
+	}
+	settings[buildValidationFilenamePatterns] = &schema.Schema{
+		Type:     schema.TypeList,
+		Optional: true,
+		Elem: &schema.Schema{
+			Type:         schema.TypeString,
+			ValidateFunc: validate.NoEmptyStrings,
+		},
+	}
+	settings[buildValidationManualQueueOnly] = &schema.Schema{
+		Type:     schema.TypeBool,
+		Optional: true,
+		Default:  false,
+	}
+	// the build expires when the target branch is updated, otherwise it never expires
+	settings[buildValidationQueueOnSourceUpdateOnly] = &schema.Schema{
+		Type:     schema.TypeBool,
+		Optional: true,
+		Default:  true,
+	}
+	// minutes the build stays valid after the target branch is updated, 0 means it expires immediately
+	settings[buildValidationValidDuration] = &schema.Schema{
+		Type:         schema.TypeInt,
+		Optional:     true,
+		Default:      720,
+		ValidateFunc: validation.IntAtLeast(0),
+	}
+	return r
+}
+
+func expandBuildValidationPolicySettings(clients *config.AggregatedClient, tfSettings map[string]interface{}, policySettings map[string]interface{}) error {
+	filenamePatterns := []string{}
+	for _, pattern := range tfSettings[buildValidationFilenamePatterns].([]interface{}) {
+		filenamePatterns = append(filenamePatterns, pattern.(string))
+	}
+
+	policySettings["buildDefinitionId"] = tfSettings[buildValidationDefinitionID].(int)
+	policySettings["displayName"] = tfSettings[buildValidationDisplayName].(string)
+	policySettings["filenamePatterns"] = filenamePatterns
+	policySettings["manualQueueOnly"] = tfSettings[buildValidationManualQueueOnly].(bool)
+	policySettings["queueOnSourceUpdateOnly"] = tfSettings[buildValidationQueueOnSourceUpdateOnly].(bool)
+	policySettings["validDuration"] = tfSettings[buildValidationValidDuration].(int)
+	return nil
+}
+
+func flattenBuildValidationPolicySettings(clients *config.AggregatedClient, policySettings map[string]interface{}, tfSettings map[string]interface{}) error {
+	tfSettings[buildValidationDefinitionID] = crud.GetInt(policySettings, "buildDefinitionId")
+	tfSettings[buildValidationDisplayName] = crud.GetString(policySettings, "displayName")
+	tfSettings[buildValidationFilenamePatterns] = crud.GetStrings(policySettings, "filenamePatterns")
+	tfSettings[buildValidationManualQueueOnly] = crud.GetBool(policySettings, "manualQueueOnly")
+	tfSettings[buildValidationQueueOnSourceUpdateOnly] = crud.GetBool(policySettings, "queueOnSourceUpdateOnly")
+	tfSettings[buildValidationValidDuration] = crud.GetInt(policySettings, "validDuration")
+	return nil
+}
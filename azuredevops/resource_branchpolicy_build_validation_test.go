@@ -0,0 +1,140 @@
+// +build all core resource_branchpolicy_build_validation
+
+package azuredevops
+
+// The tests in this file use the mock clients in mock_client.go to mock out
+// the Azure DevOps client operations.
+
+import (
+	"context"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/microsoft/azure-devops-go-api/azuredevops/policy"
+	"github.com/microsoft/terraform-provider-azuredevops/azdosdkmocks"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/utils/config"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/utils/converter"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/utils/testhelper"
+	"github.com/stretchr/testify/require"
+)
+
+/**
+ * Begin unit tests
+ */
+
+// verifies that the build validation settings are sent to the service and read back from the response
+func TestBranchPolicyBuildValidation_Create_ExpandsAndFlattensSettings(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	policyClient := azdosdkmocks.NewMockPolicyClient(ctrl)
+	clients := &config.AggregatedClient{PolicyClient: policyClient, Ctx: context.Background()}
+
+	resourceData := schema.TestResourceDataRaw(t, resourceBranchPolicyBuildValidation().Schema, nil)
+	resourceData.Set("project_id", testPolicyProjectID)
+	resourceData.Set("settings", []interface{}{map[string]interface{}{
+		"build_definition_id":         5,
+		"display_name":                "PR build",
+		"filename_patterns":           []interface{}{"/src/*", "!/src/*.md"},
+		"manual_queue_only":           true,
+		"queue_on_source_update_only": false,
+		"valid_duration":              60,
+		"scope": []interface{}{map[string]interface{}{
+			"repository_id":  testPolicyRepoID,
+			"repository_ref": "refs/heads/master",
+			"match_type":     "Exact",
+		}},
+	}})
+
+	policyClient.
+		EXPECT().
+		CreatePolicyConfiguration(clients.Ctx, gomock.Any()).
+		DoAndReturn(func(ctx context.Context, args policy.CreatePolicyConfigurationArgs) (*policy.PolicyConfiguration, error) {
+			require.Equal(t, buildValidationPolicyType, *args.Configuration.Type.Id)
+
+			settings := args.Configuration.Settings.(map[string]interface{})
+			require.Equal(t, 5, settings["buildDefinitionId"])
+			require.Equal(t, "PR build", settings["displayName"])
+			require.Equal(t, []string{"/src/*", "!/src/*.md"}, settings["filenamePatterns"])
+			require.Equal(t, true, settings["manualQueueOnly"])
+			require.Equal(t, false, settings["queueOnSourceUpdateOnly"])
+			require.Equal(t, 60, settings["validDuration"])
+
+			// settings as they are decoded from the service response
+			return &policy.PolicyConfiguration{
+				Id:         converter.Int(7),
+				IsEnabled:  args.Configuration.IsEnabled,
+				IsBlocking: args.Configuration.IsBlocking,
+				Type:       args.Configuration.Type,
+				Settings: map[string]interface{}{
+					"buildDefinitionId":       float64(5),
+					"displayName":             "PR build",
+					"filenamePatterns":        []interface{}{"/src/*", "!/src/*.md"},
+					"manualQueueOnly":         true,
+					"queueOnSourceUpdateOnly": false,
+					"validDuration":           float64(60),
+					"scope": []interface{}{map[string]interface{}{
+						"repositoryId": testPolicyRepoID,
+						"refName":      "refs/heads/master",
+						"matchKind":    "Exact",
+					}},
+				},
+			}, nil
+		}).
+		Times(1)
+
+	err := resourceBranchPolicyBuildValidation().Create(resourceData, clients)
+	require.Nil(t, err)
+	require.Equal(t, "7", resourceData.Id())
+	require.Equal(t, 5, resourceData.Get("settings.0.build_definition_id"))
+	require.Equal(t, []interface{}{"/src/*", "!/src/*.md"}, resourceData.Get("settings.0.filename_patterns"))
+	require.Equal(t, 60, resourceData.Get("settings.0.valid_duration"))
+	require.Equal(t, testPolicyRepoID, resourceData.Get("settings.0.scope.0.repository_id"))
+}
+
+/**
+ * Begin acceptance tests
+ */
+
+// Verifies that a build validation policy can be created, updated and imported
+func TestAccBranchPolicyBuildValidation_CreateAndUpdate(t *testing.T) {
+	projectName := testhelper.TestAccResourcePrefix + acctest.RandStringFromCharSet(10, acctest.CharSetAlphaNum)
+	gitRepoName := testhelper.TestAccResourcePrefix + acctest.RandStringFromCharSet(10, acctest.CharSetAlphaNum)
+	tfPolicyNode := "azuredevops_branch_policy_build_validation.policy"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testhelper.TestAccPreCheck(t, nil) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccBranchPolicyCheckDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testhelper.TestAccBranchPolicyBuildValidationResource(projectName, gitRepoName, "PR build"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet(tfPolicyNode, "settings.0.build_definition_id"),
+					resource.TestCheckResourceAttr(tfPolicyNode, "settings.0.display_name", "PR build"),
+					resource.TestCheckResourceAttr(tfPolicyNode, "settings.0.filename_patterns.#", "2"),
+					resource.TestCheckResourceAttr(tfPolicyNode, "settings.0.valid_duration", "60"),
+				),
+			},
+			{
+				Config: testhelper.TestAccBranchPolicyBuildValidationResource(projectName, gitRepoName, "Required PR build"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(tfPolicyNode, "settings.0.display_name", "Required PR build"),
+				),
+			},
+			{
+				ResourceName:      tfPolicyNode,
+				ImportStateIdFunc: testAccBranchPolicyImportStateIDFunc(tfPolicyNode),
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func init() {
+	InitProvider()
+}
@@ -0,0 +1,16 @@
+package azuredevops
+
+import (
+	"github.com/google/uuid"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	crud "github.com/microsoft/terraform-provider-azuredevops/azuredevops/crud/branchpolicy"
+)
+
+var commentResolutionPolicyType = uuid.MustParse("c6a1889d-b943-4856-b76f-9e46bb6b0df2")
+
+// The policy has no settings besides its scope. Resolving comments is required if the policy is blocking.
+func resourceBranchPolicyCommentResolution() *schema.Resource {
+	return crud.GenBasePolicyResource(&crud.PolicyCrudArgs{
+		PolicyType: commentResolutionPolicyType,
+	})
+}
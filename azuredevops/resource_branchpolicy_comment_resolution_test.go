@@ -0,0 +1,72 @@
+// +build all core resource_branchpolicy_comment_resolution
+
+package azuredevops
+
+// The tests in this file use the mock clients in mock_client.go to mock out
+// the Azure DevOps client operations.
+
+import (
+	"context"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/microsoft/azure-devops-go-api/azuredevops/policy"
+	"github.com/microsoft/terraform-provider-azuredevops/azdosdkmocks"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/utils/config"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/utils/converter"
+	"github.com/stretchr/testify/require"
+)
+
+/**
+ * Begin unit tests
+ */
+
+// verifies that a policy without settings besides its scope is created with the scope only
+func TestBranchPolicyCommentResolution_Create_SendsScopeOnly(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	policyClient := azdosdkmocks.NewMockPolicyClient(ctrl)
+	clients := &config.AggregatedClient{PolicyClient: policyClient, Ctx: context.Background()}
+
+	resourceData := schema.TestResourceDataRaw(t, resourceBranchPolicyCommentResolution().Schema, nil)
+	resourceData.Set("project_id", testPolicyProjectID)
+	resourceData.Set("blocking", false)
+	resourceData.Set("settings", []interface{}{map[string]interface{}{
+		"scope": []interface{}{map[string]interface{}{
+			"match_type": "DefaultBranch",
+		}},
+	}})
+
+	policyClient.
+		EXPECT().
+		CreatePolicyConfiguration(clients.Ctx, gomock.Any()).
+		DoAndReturn(func(ctx context.Context, args policy.CreatePolicyConfigurationArgs) (*policy.PolicyConfiguration, error) {
+			require.Equal(t, commentResolutionPolicyType, *args.Configuration.Type.Id)
+			require.False(t, *args.Configuration.IsBlocking)
+			require.Equal(t, map[string]interface{}{
+				"scope": []map[string]interface{}{{"repositoryId": nil, "matchKind": "DefaultBranch"}},
+			}, args.Configuration.Settings)
+
+			return &policy.PolicyConfiguration{
+				Id:         converter.Int(4),
+				IsEnabled:  args.Configuration.IsEnabled,
+				IsBlocking: args.Configuration.IsBlocking,
+				Type:       args.Configuration.Type,
+				Settings: map[string]interface{}{
+					"scope": []interface{}{map[string]interface{}{"repositoryId": nil, "matchKind": "DefaultBranch"}},
+				},
+			}, nil
+		}).
+		Times(1)
+
+	err := resourceBranchPolicyCommentResolution().Create(resourceData, clients)
+	require.Nil(t, err)
+	require.Equal(t, "4", resourceData.Id())
+	require.Equal(t, false, resourceData.Get("blocking"))
+}
+
+func init() {
+	InitProvider()
+}
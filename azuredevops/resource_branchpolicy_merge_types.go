@@ -0,0 +1,61 @@
+package azuredevops
+
+import (
+	"fmt"
+
+	"github.com/google/uuid"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	crud "github.com/microsoft/terraform-provider-azuredevops/azuredevops/crud/branchpolicy"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/utils/config"
+)
+
+var mergeTypesPolicyType = uuid.MustParse("fa4e907d-c16b-4a4c-9dfa-4916e5d171ab")
+
+const (
+	mergeTypeAllowSquash               = "allow_squash"
+	mergeTypeAllowRebaseAndFastForward = "allow_rebase_and_fast_forward"
+	mergeTypeAllowRebaseWithMerge      = "allow_rebase_with_merge"
+	mergeTypeAllowBasicNoFastForward   = "allow_basic_no_fast_forward"
+)
+
+func resourceBranchPolicyMergeTypes() *schema.Resource {
+	r := crud.GenBasePolicyResource(&crud.PolicyCrudArgs{
+		PolicyType:  mergeTypesPolicyType,
+		ExpandFunc:  expandMergeTypesPolicySettings,
+		FlattenFunc: flattenMergeTypesPolicySettings,
+	})
+
+	settings := crud.SettingsSchema(r)
+	for _, mergeType := range []string{mergeTypeAllowSquash, mergeTypeAllowRebaseAndFastForward, mergeTypeAllowRebaseWithMerge, mergeTypeAllowBasicNoFastForward} {
+		settings[mergeType] = &schema.Schema{
+			Type:     schema.TypeBool,
+			Optional: true,
+			Default:  false,
+		}
+	}
+	return r
+}
+
+func expandMergeTypesPolicySettings(clients *config.AggregatedClient, tfSettings map[string]interface{}, policySettings map[string]interface{}) error {
+	allowSquash := tfSettings[mergeTypeAllowSquash].(bool)
+	allowRebase := tfSettings[mergeTypeAllowRebaseAndFastForward].(bool)
+	allowRebaseMerge := tfSettings[mergeTypeAllowRebaseWithMerge].(bool)
+	allowNoFastForward := tfSettings[mergeTypeAllowBasicNoFastForward].(bool)
+	if !allowSquash && !allowRebase && !allowRebaseMerge && !allowNoFastForward {
+		return fmt.Errorf("At least one merge type has to be allowed")
+	}
+
+	policySettings["allowSquash"] = allowSquash
+	policySettings["allowRebase"] = allowRebase
+	policySettings["allowRebaseMerge"] = allowRebaseMerge
+	policySettings["allowNoFastForward"] = allowNoFastForward
+	return nil
+}
+
+func flattenMergeTypesPolicySettings(clients *config.AggregatedClient, policySettings map[string]interface{}, tfSettings map[string]interface{}) error {
+	tfSettings[mergeTypeAllowSquash] = crud.GetBool(policySettings, "allowSquash")
+	tfSettings[mergeTypeAllowRebaseAndFastForward] = crud.GetBool(policySettings, "allowRebase")
+	tfSettings[mergeTypeAllowRebaseWithMerge] = crud.GetBool(policySettings, "allowRebaseMerge")
+	tfSettings[mergeTypeAllowBasicNoFastForward] = crud.GetBool(policySettings, "allowNoFastForward")
+	return nil
+}
@@ -0,0 +1,102 @@
+// +build all core resource_branchpolicy_merge_types
+
+package azuredevops
+
+// The tests in this file use the mock clients in mock_client.go to mock out
+// the Azure DevOps client operations.
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/utils/testhelper"
+	"github.com/stretchr/testify/require"
+)
+
+/**
+ * Begin unit tests
+ */
+
+// verifies that the flatten/expand round trip yields the same merge types
+func TestBranchPolicyMergeTypes_ExpandFlatten_Roundtrip(t *testing.T) {
+	// settings as they are decoded from the service response
+	policySettings := map[string]interface{}{
+		"allowSquash":        true,
+		"allowRebase":        false,
+		"allowRebaseMerge":   true,
+		"allowNoFastForward": false,
+	}
+
+	tfSettings := map[string]interface{}{}
+	require.Nil(t, flattenMergeTypesPolicySettings(nil, policySettings, tfSettings))
+	require.Equal(t, true, tfSettings["allow_squash"])
+	require.Equal(t, true, tfSettings["allow_rebase_with_merge"])
+
+	policySettingsAfterRoundTrip := map[string]interface{}{}
+	require.Nil(t, expandMergeTypesPolicySettings(nil, tfSettings, policySettingsAfterRoundTrip))
+	require.Equal(t, policySettings, policySettingsAfterRoundTrip)
+}
+
+// verifies that a policy which does not allow any merge type is rejected
+func TestBranchPolicyMergeTypes_Expand_RequiresMergeType(t *testing.T) {
+	tfSettings := map[string]interface{}{
+		"allow_squash":                  false,
+		"allow_rebase_and_fast_forward": false,
+		"allow_rebase_with_merge":       false,
+		"allow_basic_no_fast_forward":   false,
+	}
+
+	err := expandMergeTypesPolicySettings(nil, tfSettings, map[string]interface{}{})
+	require.NotNil(t, err)
+	require.Contains(t, err.Error(), "At least one merge type")
+}
+
+/**
+ * Begin acceptance tests
+ */
+
+// Verifies that the merge types, comment resolution and work item linking policies can be created and switched
+// between blocking and optional
+func TestAccBranchPolicyPullRequestPolicies_CreateAndUpdate(t *testing.T) {
+	projectName := testhelper.TestAccResourcePrefix + acctest.RandStringFromCharSet(10, acctest.CharSetAlphaNum)
+	gitRepoName := testhelper.TestAccResourcePrefix + acctest.RandStringFromCharSet(10, acctest.CharSetAlphaNum)
+	mergeTypesNode := "azuredevops_branch_policy_merge_types.policy"
+	commentResolutionNode := "azuredevops_branch_policy_comment_resolution.policy"
+	workItemLinkingNode := "azuredevops_branch_policy_work_item_linking.policy"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testhelper.TestAccPreCheck(t, nil) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccBranchPolicyCheckDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testhelper.TestAccBranchPolicyPullRequestResources(projectName, gitRepoName, true),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(mergeTypesNode, "settings.0.allow_squash", "true"),
+					resource.TestCheckResourceAttr(mergeTypesNode, "settings.0.allow_rebase_and_fast_forward", "true"),
+					resource.TestCheckResourceAttr(commentResolutionNode, "blocking", "true"),
+					resource.TestCheckResourceAttr(workItemLinkingNode, "settings.0.scope.0.match_type", "Prefix"),
+				),
+			},
+			{
+				Config: testhelper.TestAccBranchPolicyPullRequestResources(projectName, gitRepoName, false),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(mergeTypesNode, "settings.0.allow_rebase_and_fast_forward", "false"),
+					resource.TestCheckResourceAttr(commentResolutionNode, "blocking", "false"),
+					resource.TestCheckResourceAttr(workItemLinkingNode, "blocking", "false"),
+				),
+			},
+			{
+				ResourceName:      workItemLinkingNode,
+				ImportStateIdFunc: testAccBranchPolicyImportStateIDFunc(workItemLinkingNode),
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func init() {
+	InitProvider()
+}
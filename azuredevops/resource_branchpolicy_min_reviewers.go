@@ -0,0 +1,74 @@
+package azuredevops
+
+import (
+	"github.com/google/uuid"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/validation"
+	crud "github.com/microsoft/terraform-provider-azuredevops/azuredevops/crud/branchpolicy"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/utils/config"
+)
+
+var minReviewersPolicyType = uuid.MustParse("fa4e907d-c16b-4a4c-9dfa-4906e5d171dd")
+
+const (
+	minReviewerCount                      = "reviewer_count"
+	minReviewerSubmitterCanVote           = "submitter_can_vote"
+	minReviewerAllowCompletionWithRejects = "allow_completion_with_rejects_or_waits"
+	minReviewerResetOnPush                = "on_push_reset_approved_votes"
+	minReviewerLastPusherCannotApprove    = "last_pusher_cannot_approve"
+)
+
+func resourceBranchPolicyMinReviewers() *schema.Resource {
+	r := crud.GenBasePolicyResource(&crud.PolicyCrudArgs{
+		PolicyType:  minReviewersPolicyType,
+		ExpandFunc:  expandMinReviewersPolicySettings,
+		FlattenFunc: flattenMinReviewersPolicySettings,
+	})
+
+	settings := crud.SettingsSchema(r)
+	settings[minReviewerCount] = &schema.Schema{
+		Type:         schema.TypeInt,
+		Optional:     true,
+		Default:      1,
+		ValidateFunc: validation.IntAtLeast(1),
+	}
+	settings[minReviewerSubmitterCanVote] = &schema.Schema{
+		Type:     schema.TypeBool,
+		Optional: true,
+		Default:  false,
+	}
+	settings[minReviewerAllowCompletionWithRejects] = &schema.Schema{
+		Type:     schema.TypeBool,
+		Optional: true,
+		Default:  false,
+	}
+	settings[minReviewerResetOnPush] = &schema.Schema{
+		Type:     schema.TypeBool,
+		Optional: true,
+		Default:  false,
+	}
+	settings[minReviewerLastPusherCannotApprove] = &schema.Schema{
+		Type:     schema.TypeBool,
+		Optional: true,
+		Default:  false,
+	}
+	return r
+}
+
+func expandMinReviewersPolicySettings(clients *config.AggregatedClient, tfSettings map[string]interface{}, policySettings map[string]interface{}) error {
+	policySettings["minimumApproverCount"] = tfSettings[minReviewerCount].(int)
+	policySettings["creatorVoteCounts"] = tfSettings[minReviewerSubmitterCanVote].(bool)
+	policySettings["allowDownvotes"] = tfSettings[minReviewerAllowCompletionWithRejects].(bool)
+	policySettings["resetOnSourcePush"] = tfSettings[minReviewerResetOnPush].(bool)
+	policySettings["blockLastPusherVote"] = tfSettings[minReviewerLastPusherCannotApprove].(bool)
+	return nil
+}
+
+func flattenMinReviewersPolicySettings(clients *config.AggregatedClient, policySettings map[string]interface{}, tfSettings map[string]interface{}) error {
+	tfSettings[minReviewerCount] = crud.GetInt(policySettings, "minimumApproverCount")
+	tfSettings[minReviewerSubmitterCanVote] = crud.GetBool(policySettings, "creatorVoteCounts")
+	tfSettings[minReviewerAllowCompletionWithRejects] = crud.GetBool(policySettings, "allowDownvotes")
+	tfSettings[minReviewerResetOnPush] = crud.GetBool(policySettings, "resetOnSourcePush")
+	tfSettings[minReviewerLastPusherCannotApprove] = crud.GetBool(policySettings, "blockLastPusherVote")
+	return nil
+}
@@ -0,0 +1,272 @@
+// +build all core resource_branchpolicy_min_reviewers
+
+package azuredevops
+
+// The tests in this file use the mock clients in mock_client.go to mock out
+// the Azure DevOps client operations.
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/google/uuid"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/microsoft/azure-devops-go-api/azuredevops/policy"
+	"github.com/microsoft/terraform-provider-azuredevops/azdosdkmocks"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/utils/config"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/utils/converter"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/utils/testhelper"
+	"github.com/stretchr/testify/require"
+)
+
+// settings as they are decoded from the service response
+var testMinReviewersPolicy = policy.PolicyConfiguration{
+	Id:         converter.Int(12),
+	IsEnabled:  converter.Bool(true),
+	IsBlocking: converter.Bool(false),
+	Type: &policy.PolicyTypeRef{
+		Id: &minReviewersPolicyType,
+	},
+	Settings: map[string]interface{}{
+		"minimumApproverCount": float64(2),
+		"creatorVoteCounts":    true,
+		"allowDownvotes":       false,
+		"resetOnSourcePush":    true,
+		"blockLastPusherVote":  false,
+		"scope": []interface{}{
+			map[string]interface{}{
+				"repositoryId": testPolicyRepoID,
+				"refName":      "refs/heads/master",
+				"matchKind":    "Exact",
+			},
+			map[string]interface{}{
+				"repositoryId": nil,
+				"matchKind":    "DefaultBranch",
+			},
+		},
+	},
+}
+
+/**
+ * Begin unit tests
+ */
+
+// verifies that the policy settings read from the service are written back unchanged on update
+func TestBranchPolicyMinReviewers_Update_RoundTripsSettings(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	policyClient := azdosdkmocks.NewMockPolicyClient(ctrl)
+	clients := &config.AggregatedClient{PolicyClient: policyClient, Ctx: context.Background()}
+
+	resourceData := schema.TestResourceDataRaw(t, resourceBranchPolicyMinReviewers().Schema, nil)
+	resourceData.SetId("12")
+	resourceData.Set("project_id", testPolicyProjectID)
+
+	policyClient.
+		EXPECT().
+		GetPolicyConfiguration(clients.Ctx, policy.GetPolicyConfigurationArgs{
+			Project:         converter.String(testPolicyProjectID),
+			ConfigurationId: converter.Int(12),
+		}).
+		Return(&testMinReviewersPolicy, nil).
+		Times(2)
+	policyClient.
+		EXPECT().
+		UpdatePolicyConfiguration(clients.Ctx, gomock.Any()).
+		DoAndReturn(func(ctx context.Context, args policy.UpdatePolicyConfigurationArgs) (*policy.PolicyConfiguration, error) {
+			require.Equal(t, 12, *args.ConfigurationId)
+			require.Equal(t, testPolicyProjectID, *args.Project)
+			require.Equal(t, minReviewersPolicyType, *args.Configuration.Type.Id)
+			require.True(t, *args.Configuration.IsEnabled)
+			require.False(t, *args.Configuration.IsBlocking)
+
+			settings := args.Configuration.Settings.(map[string]interface{})
+			require.Equal(t, 2, settings["minimumApproverCount"])
+			require.Equal(t, true, settings["creatorVoteCounts"])
+			require.Equal(t, false, settings["allowDownvotes"])
+			require.Equal(t, true, settings["resetOnSourcePush"])
+			require.Equal(t, false, settings["blockLastPusherVote"])
+			require.Equal(t, []map[string]interface{}{
+				{"repositoryId": testPolicyRepoID, "refName": "refs/heads/master", "matchKind": "Exact"},
+				{"repositoryId": nil, "matchKind": "DefaultBranch"},
+			}, settings["scope"])
+			return &testMinReviewersPolicy, nil
+		}).
+		Times(1)
+
+	r := resourceBranchPolicyMinReviewers()
+	require.Nil(t, r.Read(resourceData, clients))
+	require.Nil(t, r.Update(resourceData, clients))
+	require.Equal(t, 2, resourceData.Get("settings.0.reviewer_count"))
+	require.Equal(t, "DefaultBranch", resourceData.Get("settings.0.scope.1.match_type"))
+}
+
+// verifies that if an error is produced on create, the error is not swallowed
+func TestBranchPolicyMinReviewers_Create_DoesNotSwallowError(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	policyClient := azdosdkmocks.NewMockPolicyClient(ctrl)
+	clients := &config.AggregatedClient{PolicyClient: policyClient, Ctx: context.Background()}
+
+	resourceData := schema.TestResourceDataRaw(t, resourceBranchPolicyMinReviewers().Schema, nil)
+	resourceData.Set("project_id", testPolicyProjectID)
+	resourceData.Set("settings", []interface{}{map[string]interface{}{
+		"reviewer_count": 1,
+		"scope": []interface{}{map[string]interface{}{
+			"repository_ref": "refs/heads/master",
+			"match_type":     "Exact",
+		}},
+	}})
+
+	policyClient.
+		EXPECT().
+		CreatePolicyConfiguration(clients.Ctx, gomock.Any()).
+		Return(nil, errors.New("CreatePolicyConfiguration() Failed")).
+		Times(1)
+
+	err := resourceBranchPolicyMinReviewers().Create(resourceData, clients)
+	require.Contains(t, err.Error(), "CreatePolicyConfiguration() Failed")
+}
+
+// verifies that scopes are validated before the policy is created
+func TestBranchPolicyMinReviewers_Create_ValidatesScope(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	policyClient := azdosdkmocks.NewMockPolicyClient(ctrl)
+	clients := &config.AggregatedClient{PolicyClient: policyClient, Ctx: context.Background()}
+
+	scopes := []map[string]interface{}{
+		{"match_type": "Exact"},
+		{"match_type": "DefaultBranch", "repository_ref": "refs/heads/master"},
+	}
+	for _, scope := range scopes {
+		resourceData := schema.TestResourceDataRaw(t, resourceBranchPolicyMinReviewers().Schema, nil)
+		resourceData.Set("project_id", testPolicyProjectID)
+		resourceData.Set("settings", []interface{}{map[string]interface{}{
+			"scope": []interface{}{scope},
+		}})
+
+		err := resourceBranchPolicyMinReviewers().Create(resourceData, clients)
+		require.NotNil(t, err)
+		require.Contains(t, err.Error(), "repository_ref")
+	}
+}
+
+// verifies that a policy that has been deleted outside of Terraform is removed from the state
+func TestBranchPolicyMinReviewers_Read_RemovesDeletedPolicy(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	policyClient := azdosdkmocks.NewMockPolicyClient(ctrl)
+	clients := &config.AggregatedClient{PolicyClient: policyClient, Ctx: context.Background()}
+
+	resourceData := schema.TestResourceDataRaw(t, resourceBranchPolicyMinReviewers().Schema, nil)
+	resourceData.SetId("12")
+	resourceData.Set("project_id", testPolicyProjectID)
+
+	deletedPolicy := testMinReviewersPolicy
+	deletedPolicy.IsDeleted = converter.Bool(true)
+	policyClient.
+		EXPECT().
+		GetPolicyConfiguration(clients.Ctx, gomock.Any()).
+		Return(&deletedPolicy, nil).
+		Times(1)
+
+	err := resourceBranchPolicyMinReviewers().Read(resourceData, clients)
+	require.Nil(t, err)
+	require.Equal(t, "", resourceData.Id())
+}
+
+// verifies that a policy of another type cannot be managed by the resource
+func TestBranchPolicyMinReviewers_Read_RejectsOtherPolicyType(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	policyClient := azdosdkmocks.NewMockPolicyClient(ctrl)
+	clients := &config.AggregatedClient{PolicyClient: policyClient, Ctx: context.Background()}
+
+	resourceData := schema.TestResourceDataRaw(t, resourceBranchPolicyMinReviewers().Schema, nil)
+	resourceData.SetId("12")
+	resourceData.Set("project_id", testPolicyProjectID)
+
+	otherType := uuid.New()
+	otherPolicy := testMinReviewersPolicy
+	otherPolicy.Type = &policy.PolicyTypeRef{Id: &otherType}
+	policyClient.
+		EXPECT().
+		GetPolicyConfiguration(clients.Ctx, gomock.Any()).
+		Return(&otherPolicy, nil).
+		Times(1)
+
+	err := resourceBranchPolicyMinReviewers().Read(resourceData, clients)
+	require.NotNil(t, err)
+	require.Contains(t, err.Error(), "is not of type")
+}
+
+// verifies that the import ID is split into project and policy configuration
+func TestBranchPolicyMinReviewers_Import_ParsesID(t *testing.T) {
+	r := resourceBranchPolicyMinReviewers()
+	resourceData := schema.TestResourceDataRaw(t, r.Schema, nil)
+	resourceData.SetId(testPolicyProjectID + "/12")
+
+	_, err := r.Importer.State(resourceData, nil)
+	require.Nil(t, err)
+	require.Equal(t, testPolicyProjectID, resourceData.Get("project_id"))
+	require.Equal(t, "12", resourceData.Id())
+
+	resourceData.SetId(testPolicyProjectID + "/master")
+	_, err = r.Importer.State(resourceData, nil)
+	require.NotNil(t, err)
+}
+
+/**
+ * Begin acceptance tests
+ */
+
+// Verifies that a minimum reviewers policy can be created, updated and imported
+func TestAccBranchPolicyMinReviewers_CreateAndUpdate(t *testing.T) {
+	projectName := testhelper.TestAccResourcePrefix + acctest.RandStringFromCharSet(10, acctest.CharSetAlphaNum)
+	gitRepoName := testhelper.TestAccResourcePrefix + acctest.RandStringFromCharSet(10, acctest.CharSetAlphaNum)
+	tfPolicyNode := "azuredevops_branch_policy_min_reviewers.policy"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testhelper.TestAccPreCheck(t, nil) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccBranchPolicyCheckDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testhelper.TestAccBranchPolicyMinReviewersResource(projectName, gitRepoName, 1),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet(tfPolicyNode, "id"),
+					resource.TestCheckResourceAttr(tfPolicyNode, "enabled", "true"),
+					resource.TestCheckResourceAttr(tfPolicyNode, "blocking", "true"),
+					resource.TestCheckResourceAttr(tfPolicyNode, "settings.0.reviewer_count", "1"),
+					resource.TestCheckResourceAttr(tfPolicyNode, "settings.0.on_push_reset_approved_votes", "true"),
+				),
+			},
+			{
+				Config: testhelper.TestAccBranchPolicyMinReviewersResource(projectName, gitRepoName, 2),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(tfPolicyNode, "settings.0.reviewer_count", "2"),
+				),
+			},
+			{
+				ResourceName:      tfPolicyNode,
+				ImportStateIdFunc: testAccBranchPolicyImportStateIDFunc(tfPolicyNode),
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func init() {
+	InitProvider()
+}
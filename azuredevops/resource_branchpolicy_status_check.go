@@ -0,0 +1,105 @@
+package azuredevops
+
+import (
+	"github.com/google/uuid"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	crud "github.com/microsoft/terraform-provider-azuredevops/azuredevops/crud/branchpolicy"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/utils/config"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/utils/validate"
+)
+
+var statusCheckPolicyType = uuid.MustParse("cbdc66da-9728-4af8-aada-9a5a32e4a226")
+
+const (
+	statusCheckName               = "name"
+	statusCheckGenre              = "genre"
+	statusCheckAuthorID           = "author_id"
+	statusCheckInvalidateOnUpdate = "invalidate_on_update"
+	statusCheckFilenamePatterns   = "filename_patterns"
+	statusCheckDisplayName        = "display_name"
+)
+
+func resourceBranchPolicyStatusCheck() *schema.Resource {
+	r := crud.GenBasePolicyResource(&crud.PolicyCrudArgs{
+		PolicyType:  statusCheckPolicyType,
+		ExpandFunc:  expandStatusCheckPolicySettings,
+		FlattenFunc: flattenStatusCheckPolicySettings,
+	})
+
+	settings := crud.SettingsSchema(r)
+	settings[statusCheckName] = &schema.Schema{
+		Type:         schema.TypeString,
+		Required:     true,
+		ValidateFunc: validate.NoEmptyStrings,
+	}
+	settings[statusCheckGenre] = &schema.Schema{
+		Type:     schema.TypeString,
+		Optional: true,
+	}
+	// descriptor of the only identity that is allowed to post the status
+	settings[statusCheckAuthorID] = &schema.Schema{
+		Type:     schema.TypeString,
+		Optional: true,
+	}
+	settings[statusCheckInvalidateOnUpdate] = &schema.Schema{
+		Type:     schema.TypeBool,
+		Optional: true,
+		Default:  false,
+	}
+	settings[statusCheckFilenamePatterns] = &schema.Schema{
+		Type:     schema.TypeList,
+		Optional: true,
+		Elem: &schema.Schema{
+			Type:         schema.TypeString,
+			ValidateFunc: validate.NoEmptyStrings,
+		},
+	}
+	settings[statusCheckDisplayName] = &schema.Schema{
+		Type:     schema.TypeString,
+		Optional: true,
+	}
+	return r
+}
+
+func expandStatusCheckPolicySettings(clients *config.AggregatedClient, tfSettings map[string]interface{}, policySettings map[string]interface{}) error {
+	filenamePatterns := []string{}
+	for _, pattern := range tfSettings[statusCheckFilenamePatterns].([]interface{}) {
+		filenamePatterns = append(filenamePatterns, pattern.(string))
+	}
+
+	policySettings["statusName"] = tfSettings[statusCheckName].(string)
+	policySettings["statusGenre"] = tfSettings[statusCheckGenre].(string)
+	policySettings["invalidateOnSourceUpdate"] = tfSettings[statusCheckInvalidateOnUpdate].(bool)
+	policySettings["filenamePatterns"] = filenamePatterns
+	policySettings["defaultDisplayName"] = tfSettings[statusCheckDisplayName].(string)
+
+	// the policy expects the ID of the identity instead of its descriptor. The author is always sent, so that it is
+	// removed from an existing policy if it is no longer configured.
+	policySettings["authorId"] = nil
+	if author := tfSettings[statusCheckAuthorID].(string); author != "" {
+		authorID, err := getPrincipalIdentityID(clients, author)
+		if err != nil {
+			return err
+		}
+		policySettings["authorId"] = authorID
+	}
+	return nil
+}
+
+func flattenStatusCheckPolicySettings(clients *config.AggregatedClient, policySettings map[string]interface{}, tfSettings map[string]interface{}) error {
+	tfSettings[statusCheckName] = crud.GetString(policySettings, "statusName")
+	tfSettings[statusCheckGenre] = crud.GetString(policySettings, "statusGenre")
+	tfSettings[statusCheckInvalidateOnUpdate] = crud.GetBool(policySettings, "invalidateOnSourceUpdate")
+	tfSettings[statusCheckFilenamePatterns] = crud.GetStrings(policySettings, "filenamePatterns")
+	tfSettings[statusCheckDisplayName] = crud.GetString(policySettings, "defaultDisplayName")
+
+	tfSettings[statusCheckAuthorID] = ""
+	if authorID := crud.GetString(policySettings, "authorId"); authorID != "" {
+		descriptors, err := getIdentitySubjectDescriptors(clients, []string{authorID})
+		if err != nil {
+			return err
+		}
+		tfSettings[statusCheckAuthorID] = descriptors[0]
+	}
+	return nil
+}
@@ -0,0 +1,135 @@
+// +build all core resource_branchpolicy_status_check
+
+package azuredevops
+
+// The tests in this file use the mock clients in mock_client.go to mock out
+// the Azure DevOps client operations.
+
+import (
+	"context"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/google/uuid"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
+	"github.com/microsoft/azure-devops-go-api/azuredevops/identity"
+	"github.com/microsoft/terraform-provider-azuredevops/azdosdkmocks"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/utils/config"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/utils/converter"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/utils/testhelper"
+	"github.com/stretchr/testify/require"
+)
+
+/**
+ * Begin unit tests
+ */
+
+// verifies that the authorized identity is sent as identity ID and read back as descriptor
+func TestBranchPolicyStatusCheck_ExpandFlatten_ResolvesAuthor(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	identityClient := azdosdkmocks.NewMockIdentityClient(ctrl)
+	clients := &config.AggregatedClient{IdentityClient: identityClient, Ctx: context.Background()}
+
+	authorID := uuid.New()
+	identityClient.
+		EXPECT().
+		ReadIdentities(clients.Ctx, identity.ReadIdentitiesArgs{SubjectDescriptors: converter.String("aad.scanner")}).
+		Return(&[]identity.Identity{{Id: &authorID}}, nil).
+		Times(1)
+	identityClient.
+		EXPECT().
+		ReadIdentities(clients.Ctx, identity.ReadIdentitiesArgs{IdentityIds: converter.String(authorID.String())}).
+		Return(&[]identity.Identity{{Id: &authorID, SubjectDescriptor: converter.String("aad.scanner")}}, nil).
+		Times(1)
+
+	tfSettings := map[string]interface{}{
+		"name":                 "scan",
+		"genre":                "security",
+		"author_id":            "aad.scanner",
+		"invalidate_on_update": true,
+		"filename_patterns":    []interface{}{"/src/*"},
+		"display_name":         "Security scan",
+	}
+	policySettings := map[string]interface{}{}
+	require.Nil(t, expandStatusCheckPolicySettings(clients, tfSettings, policySettings))
+	require.Equal(t, map[string]interface{}{
+		"statusName":               "scan",
+		"statusGenre":              "security",
+		"authorId":                 authorID.String(),
+		"invalidateOnSourceUpdate": true,
+		"filenamePatterns":         []string{"/src/*"},
+		"defaultDisplayName":       "Security scan",
+	}, policySettings)
+
+	tfSettingsAfterRoundTrip := map[string]interface{}{}
+	require.Nil(t, flattenStatusCheckPolicySettings(clients, policySettings, tfSettingsAfterRoundTrip))
+	require.Equal(t, "aad.scanner", tfSettingsAfterRoundTrip["author_id"])
+	require.Equal(t, []string{"/src/*"}, tfSettingsAfterRoundTrip["filename_patterns"])
+	require.Equal(t, true, tfSettingsAfterRoundTrip["invalidate_on_update"])
+}
+
+// verifies that statuses can be posted by any identity if no author is configured
+func TestBranchPolicyStatusCheck_Expand_WithoutAuthor(t *testing.T) {
+	tfSettings := map[string]interface{}{
+		"name":                 "scan",
+		"genre":                "",
+		"author_id":            "",
+		"invalidate_on_update": false,
+		"filename_patterns":    []interface{}{},
+		"display_name":         "",
+	}
+	policySettings := map[string]interface{}{}
+	require.Nil(t, expandStatusCheckPolicySettings(nil, tfSettings, policySettings))
+	require.Contains(t, policySettings, "authorId")
+	require.Nil(t, policySettings["authorId"])
+
+	tfSettingsAfterRoundTrip := map[string]interface{}{}
+	require.Nil(t, flattenStatusCheckPolicySettings(nil, policySettings, tfSettingsAfterRoundTrip))
+	require.Equal(t, "", tfSettingsAfterRoundTrip["author_id"])
+}
+
+/**
+ * Begin acceptance tests
+ */
+
+// Verifies that a status check policy can be created, updated and imported
+func TestAccBranchPolicyStatusCheck_CreateAndUpdate(t *testing.T) {
+	projectName := testhelper.TestAccResourcePrefix + acctest.RandStringFromCharSet(10, acctest.CharSetAlphaNum)
+	gitRepoName := testhelper.TestAccResourcePrefix + acctest.RandStringFromCharSet(10, acctest.CharSetAlphaNum)
+	tfPolicyNode := "azuredevops_branch_policy_status_check.policy"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testhelper.TestAccPreCheck(t, nil) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccBranchPolicyCheckDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testhelper.TestAccBranchPolicyStatusCheckResource(projectName, gitRepoName, false),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(tfPolicyNode, "settings.0.genre", "security"),
+					resource.TestCheckResourceAttr(tfPolicyNode, "settings.0.name", "scan"),
+					resource.TestCheckResourceAttr(tfPolicyNode, "settings.0.invalidate_on_update", "false"),
+				),
+			},
+			{
+				Config: testhelper.TestAccBranchPolicyStatusCheckResource(projectName, gitRepoName, true),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(tfPolicyNode, "settings.0.invalidate_on_update", "true"),
+				),
+			},
+			{
+				ResourceName:      tfPolicyNode,
+				ImportStateIdFunc: testAccBranchPolicyImportStateIDFunc(tfPolicyNode),
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func init() {
+	InitProvider()
+}
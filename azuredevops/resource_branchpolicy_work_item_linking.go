@@ -0,0 +1,16 @@
+package azuredevops
+
+import (
+	"github.com/google/uuid"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	crud "github.com/microsoft/terraform-provider-azuredevops/azuredevops/crud/branchpolicy"
+)
+
+var workItemLinkingPolicyType = uuid.MustParse("40e92b44-2fe1-4dd6-b3d8-74a9c21d0c6e")
+
+// The policy has no settings besides its scope. Linking work items is required if the policy is blocking.
+func resourceBranchPolicyWorkItemLinking() *schema.Resource {
+	return crud.GenBasePolicyResource(&crud.PolicyCrudArgs{
+		PolicyType: workItemLinkingPolicyType,
+	})
+}
@@ -0,0 +1,65 @@
+// +build all core resource_branchpolicy_work_item_linking
+
+package azuredevops
+
+// The tests in this file use the mock clients in mock_client.go to mock out
+// the Azure DevOps client operations.
+
+import (
+	"context"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/microsoft/azure-devops-go-api/azuredevops/policy"
+	"github.com/microsoft/terraform-provider-azuredevops/azdosdkmocks"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/utils/config"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/utils/converter"
+	"github.com/stretchr/testify/require"
+)
+
+/**
+ * Begin unit tests
+ */
+
+// verifies that the policy is read back with the scope it applies to
+func TestBranchPolicyWorkItemLinking_Read_FlattensScope(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	policyClient := azdosdkmocks.NewMockPolicyClient(ctrl)
+	clients := &config.AggregatedClient{PolicyClient: policyClient, Ctx: context.Background()}
+
+	resourceData := schema.TestResourceDataRaw(t, resourceBranchPolicyWorkItemLinking().Schema, nil)
+	resourceData.SetId("5")
+	resourceData.Set("project_id", testPolicyProjectID)
+
+	policyClient.
+		EXPECT().
+		GetPolicyConfiguration(clients.Ctx, gomock.Any()).
+		Return(&policy.PolicyConfiguration{
+			Id:         converter.Int(5),
+			IsEnabled:  converter.Bool(true),
+			IsBlocking: converter.Bool(true),
+			Type:       &policy.PolicyTypeRef{Id: &workItemLinkingPolicyType},
+			Settings: map[string]interface{}{
+				"scope": []interface{}{map[string]interface{}{
+					"repositoryId": testPolicyRepoID,
+					"refName":      "refs/heads/releases",
+					"matchKind":    "Prefix",
+				}},
+			},
+		}, nil).
+		Times(1)
+
+	err := resourceBranchPolicyWorkItemLinking().Read(resourceData, clients)
+	require.Nil(t, err)
+	require.Equal(t, true, resourceData.Get("blocking"))
+	require.Equal(t, testPolicyRepoID, resourceData.Get("settings.0.scope.0.repository_id"))
+	require.Equal(t, "refs/heads/releases", resourceData.Get("settings.0.scope.0.repository_ref"))
+	require.Equal(t, "Prefix", resourceData.Get("settings.0.scope.0.match_type"))
+}
+
+func init() {
+	InitProvider()
+}
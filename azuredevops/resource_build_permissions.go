@@ -0,0 +1,121 @@
+package azuredevops
+
+import (
+	"fmt"
+	"net/http"
+	"strconv"
+	"strings"
+
+	"github.com/google/uuid"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/validation"
+	"github.com/microsoft/azure-devops-go-api/azuredevops"
+	"github.com/microsoft/azure-devops-go-api/azuredevops/build"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/utils/config"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/utils/converter"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/utils/validate"
+)
+
+// Permissions on build folders and build definitions are managed in the Build security namespace
+var buildSecurityNamespaceID = uuid.MustParse("33344d9c-fc72-4d6f-aba5-fa317101a7e9")
+
+func resourceBuildPermissions() *schema.Resource {
+	return genSecurityPermissionsResource(buildSecurityNamespaceID, map[string]*schema.Schema{
+		"project_id": {
+			Type:         schema.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: validate.UUID,
+		},
+		"path": {
+			Type:          schema.TypeString,
+			Optional:      true,
+			ForceNew:      true,
+			ValidateFunc:  validate.FilePathOrEmpty,
+			ConflictsWith: []string{"build_definition_id"},
+		},
+		"build_definition_id": {
+			Type:          schema.TypeInt,
+			Optional:      true,
+			ForceNew:      true,
+			ValidateFunc:  validation.IntAtLeast(1),
+			ConflictsWith: []string{"path"},
+		},
+	}, createBuildSecurityToken, parseBuildPermissionsImportID)
+}
+
+// Builds the token of a project (<project ID>), a folder (<project ID>/<folder>/<subfolder>) or a
+// build definition (<project ID>/<folder>/<subfolder>/<definition ID>). The token of a build definition
+// contains the folder of the definition, which is read from the definition.
+func createBuildSecurityToken(d *schema.ResourceData, clients *config.AggregatedClient) (string, error) {
+	projectID := d.Get("project_id").(string)
+	path := d.Get("path").(string)
+
+	if definitionID, ok := d.GetOk("build_definition_id"); ok {
+		definition, err := clients.BuildClient.GetDefinition(clients.Ctx, build.GetDefinitionArgs{
+			Project:      converter.String(projectID),
+			DefinitionId: converter.Int(definitionID.(int)),
+		})
+		if isBuildDefinitionNotFoundError(err) || (err == nil && definition == nil) {
+			return "", &securityTargetNotFoundError{fmt.Sprintf("Build definition %d does not exist in project %s", definitionID.(int), projectID)}
+		}
+		if err != nil {
+			return "", fmt.Errorf("Error reading build definition %d: %+v", definitionID.(int), err)
+		}
+		return getBuildSecurityToken(projectID, converter.ToString(definition.Path, ""), strconv.Itoa(definitionID.(int))), nil
+	}
+	return getBuildSecurityToken(projectID, path, ""), nil
+}
+
+func isBuildDefinitionNotFoundError(err error) bool {
+	var wrappedError *azuredevops.WrappedError
+	switch e := err.(type) {
+	case azuredevops.WrappedError:
+		wrappedError = &e
+	case *azuredevops.WrappedError:
+		wrappedError = e
+	default:
+		return false
+	}
+	return wrappedError.StatusCode != nil && *wrappedError.StatusCode == http.StatusNotFound
+}
+
+func getBuildSecurityToken(projectID string, path string, definitionID string) string {
+	segments := []string{projectID}
+	for _, folder := range strings.Split(path, "\\") {
+		if folder != "" {
+			segments = append(segments, folder)
+		}
+	}
+	if definitionID != "" {
+		segments = append(segments, definitionID)
+	}
+	return strings.Join(segments, "/")
+}
+
+// Import ID is of the form <project ID>[/<folder path>|/<definition ID>]/<principal descriptor>, where folder
+// paths start with a backslash, e.g. 782a8123-1019-xxxx-xxxx-xxxxxxxx/\folder\subfolder/vssgp.xxx
+func parseBuildPermissionsImportID(d *schema.ResourceData) error {
+	parts := strings.Split(d.Id(), "/")
+	if len(parts) < 2 || len(parts) > 3 || parts[len(parts)-1] == "" {
+		return fmt.Errorf("Error parsing import ID %s. Expected format <project ID>[/<folder path>|/<definition ID>]/<principal descriptor>", d.Id())
+	}
+	if _, err := uuid.Parse(parts[0]); err != nil {
+		return fmt.Errorf("Error parsing import ID %s. Project ID %s is not a valid UUID", d.Id(), parts[0])
+	}
+
+	d.Set("project_id", parts[0])
+	if len(parts) == 3 {
+		if strings.HasPrefix(parts[1], "\\") {
+			d.Set("path", parts[1])
+		} else {
+			definitionID, err := strconv.Atoi(parts[1])
+			if err != nil {
+				return fmt.Errorf("Error parsing import ID %s. %s is neither a folder path nor a build definition ID", d.Id(), parts[1])
+			}
+			d.Set("build_definition_id", definitionID)
+		}
+	}
+	d.Set("principal", parts[len(parts)-1])
+	return nil
+}
@@ -0,0 +1,156 @@
+// +build all security resource_build_permissions
+
+package azuredevops
+
+// The tests in this file use the mock clients in mock_client.go to mock out
+// the Azure DevOps client operations.
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/google/uuid"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/microsoft/azure-devops-go-api/azuredevops"
+	"github.com/microsoft/azure-devops-go-api/azuredevops/build"
+	"github.com/microsoft/terraform-provider-azuredevops/azdosdkmocks"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/utils/config"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/utils/converter"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/utils/testhelper"
+	"github.com/stretchr/testify/require"
+)
+
+var testBuildPermissionsProjectID = uuid.New()
+
+/**
+ * Begin unit tests
+ */
+
+// verifies that the token is built for the project and folder scope
+func TestAzureDevOpsBuildPermissions_CreateToken_ProjectAndFolder(t *testing.T) {
+	resourceData := schema.TestResourceDataRaw(t, resourceBuildPermissions().Schema, nil)
+	resourceData.Set("project_id", testBuildPermissionsProjectID.String())
+
+	token, err := createBuildSecurityToken(resourceData, nil)
+	require.Nil(t, err)
+	require.Equal(t, testBuildPermissionsProjectID.String(), token)
+
+	resourceData.Set("path", "\\folder\\subfolder")
+	token, err = createBuildSecurityToken(resourceData, nil)
+	require.Nil(t, err)
+	require.Equal(t, testBuildPermissionsProjectID.String()+"/folder/subfolder", token)
+}
+
+// verifies that the token of a build definition contains the folder of the definition
+func TestAzureDevOpsBuildPermissions_CreateToken_Definition(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	buildClient := azdosdkmocks.NewMockBuildClient(ctrl)
+	clients := &config.AggregatedClient{
+		BuildClient: buildClient,
+		Ctx:         context.Background(),
+	}
+
+	resourceData := schema.TestResourceDataRaw(t, resourceBuildPermissions().Schema, nil)
+	resourceData.Set("project_id", testBuildPermissionsProjectID.String())
+	resourceData.Set("build_definition_id", 42)
+
+	buildClient.
+		EXPECT().
+		GetDefinition(clients.Ctx, build.GetDefinitionArgs{
+			Project:      converter.String(testBuildPermissionsProjectID.String()),
+			DefinitionId: converter.Int(42),
+		}).
+		Return(&build.BuildDefinition{Path: converter.String("\\folder")}, nil).
+		Times(1)
+
+	token, err := createBuildSecurityToken(resourceData, clients)
+	require.Nil(t, err)
+	require.Equal(t, testBuildPermissionsProjectID.String()+"/folder/42", token)
+}
+
+// verifies that the permissions are removed from the state if the build definition does not exist anymore
+func TestAzureDevOpsBuildPermissions_Read_RemovesDeletedDefinition(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	buildClient := azdosdkmocks.NewMockBuildClient(ctrl)
+	clients := &config.AggregatedClient{
+		BuildClient: buildClient,
+		Ctx:         context.Background(),
+	}
+
+	resourceData := schema.TestResourceDataRaw(t, resourceBuildPermissions().Schema, nil)
+	resourceData.SetId("id")
+	resourceData.Set("project_id", testBuildPermissionsProjectID.String())
+	resourceData.Set("build_definition_id", 42)
+
+	buildClient.
+		EXPECT().
+		GetDefinition(clients.Ctx, gomock.Any()).
+		Return(nil, azuredevops.WrappedError{StatusCode: converter.Int(http.StatusNotFound)}).
+		Times(1)
+
+	err := resourceBuildPermissions().Read(resourceData, clients)
+	require.Nil(t, err)
+	require.Equal(t, "", resourceData.Id())
+}
+
+// verifies that the import ID is split into project, folder or definition, and principal
+func TestAzureDevOpsBuildPermissions_Import_ParsesID(t *testing.T) {
+	resourceData := schema.TestResourceDataRaw(t, resourceBuildPermissions().Schema, nil)
+	resourceData.SetId(testBuildPermissionsProjectID.String() + "/\\folder\\subfolder/vssgp.readers")
+	require.Nil(t, parseBuildPermissionsImportID(resourceData))
+	require.Equal(t, "\\folder\\subfolder", resourceData.Get("path"))
+	require.Equal(t, "vssgp.readers", resourceData.Get("principal"))
+
+	resourceData = schema.TestResourceDataRaw(t, resourceBuildPermissions().Schema, nil)
+	resourceData.SetId(testBuildPermissionsProjectID.String() + "/42/vssgp.readers")
+	require.Nil(t, parseBuildPermissionsImportID(resourceData))
+	require.Equal(t, 42, resourceData.Get("build_definition_id"))
+
+	resourceData.SetId(testBuildPermissionsProjectID.String() + "/folder/vssgp.readers")
+	require.NotNil(t, parseBuildPermissionsImportID(resourceData))
+}
+
+/**
+ * Begin acceptance tests
+ */
+
+// Verifies that the permissions of a group on a build folder and a build definition can be set and changed
+func TestAccAzureDevOpsBuildPermissions_CreateAndUpdate(t *testing.T) {
+	projectName := testhelper.TestAccResourcePrefix + acctest.RandStringFromCharSet(10, acctest.CharSetAlphaNum)
+	buildDefinitionName := testhelper.TestAccResourcePrefix + acctest.RandStringFromCharSet(10, acctest.CharSetAlphaNum)
+	folderNode := "azuredevops_build_permissions.folder"
+	definitionNode := "azuredevops_build_permissions.definition"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testhelper.TestAccPreCheck(t, nil) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccProjectCheckDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testhelper.TestAccBuildPermissionsResource(projectName, buildDefinitionName, permissionDeny),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(folderNode, "permissions.QueueBuilds", permissionDeny),
+					resource.TestCheckResourceAttr(definitionNode, "permissions.EditBuildDefinition", permissionDeny),
+				),
+			},
+			{
+				Config: testhelper.TestAccBuildPermissionsResource(projectName, buildDefinitionName, permissionAllow),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(folderNode, "permissions.QueueBuilds", permissionAllow),
+				),
+			},
+		},
+	})
+}
+
+func init() {
+	InitProvider()
+}
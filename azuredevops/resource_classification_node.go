@@ -0,0 +1,354 @@
+package azuredevops
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/microsoft/azure-devops-go-api/azuredevops/workitemtracking"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/utils/config"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/utils/converter"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/utils/validate"
+)
+
+// Date format of the start and finish dates of iterations
+const classificationNodeDateFormat = "2006-01-02"
+
+// genClassificationNodeResource creates the resource managing the nodes of an area or iteration tree.
+// Nodes are identified by their integer ID, so that they can be renamed and moved without losing track of them.
+func genClassificationNodeResource(structureGroup workitemtracking.TreeStructureGroup, withDates bool) *schema.Resource {
+	s := map[string]*schema.Schema{
+		"project_id": {
+			Type:         schema.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: validate.UUID,
+		},
+		"name": {
+			Type:         schema.TypeString,
+			Required:     true,
+			ValidateFunc: validate.NoEmptyStrings,
+		},
+		"parent_path": {
+			Type:             schema.TypeString,
+			Optional:         true,
+			Default:          "",
+			DiffSuppressFunc: suppressClassificationPathDiff,
+		},
+		"reclassify_node_id": {
+			Type:     schema.TypeInt,
+			Optional: true,
+		},
+		"node_id": {
+			Type:     schema.TypeInt,
+			Computed: true,
+		},
+		"identifier": {
+			Type:     schema.TypeString,
+			Computed: true,
+		},
+		"path": {
+			Type:     schema.TypeString,
+			Computed: true,
+		},
+		"has_children": {
+			Type:     schema.TypeBool,
+			Computed: true,
+		},
+	}
+	if withDates {
+		s["start_date"] = &schema.Schema{
+			Type:         schema.TypeString,
+			Optional:     true,
+			ValidateFunc: validateClassificationNodeDate,
+		}
+		s["finish_date"] = &schema.Schema{
+			Type:         schema.TypeString,
+			Optional:     true,
+			ValidateFunc: validateClassificationNodeDate,
+		}
+	}
+
+	return &schema.Resource{
+		Create: func(d *schema.ResourceData, m interface{}) error {
+			return resourceClassificationNodeCreate(d, m, structureGroup, withDates)
+		},
+		Read: func(d *schema.ResourceData, m interface{}) error {
+			return resourceClassificationNodeRead(d, m, withDates)
+		},
+		Update: func(d *schema.ResourceData, m interface{}) error {
+			return resourceClassificationNodeUpdate(d, m, structureGroup, withDates)
+		},
+		Delete: func(d *schema.ResourceData, m interface{}) error {
+			return resourceClassificationNodeDelete(d, m, structureGroup)
+		},
+		Importer: &schema.ResourceImporter{
+			State: importClassificationNode,
+		},
+		Schema: s,
+	}
+}
+
+func resourceClassificationNodeCreate(d *schema.ResourceData, m interface{}, structureGroup workitemtracking.TreeStructureGroup, withDates bool) error {
+	clients := m.(*config.AggregatedClient)
+	node, err := expandClassificationNode(d)
+	if err != nil {
+		return err
+	}
+
+	createdNode, err := clients.WorkItemTrackingClient.CreateOrUpdateClassificationNode(clients.Ctx, workitemtracking.CreateOrUpdateClassificationNodeArgs{
+		Project:        converter.String(d.Get("project_id").(string)),
+		StructureGroup: &structureGroup,
+		Path:           classificationPathArg(d.Get("parent_path").(string)),
+		PostedNode:     node,
+	})
+	if err != nil {
+		return fmt.Errorf("Error creating %s node %s: %+v", structureGroup, d.Get("name").(string), err)
+	}
+
+	d.SetId(strconv.Itoa(*createdNode.Id))
+	return resourceClassificationNodeRead(d, m, withDates)
+}
+
+func resourceClassificationNodeRead(d *schema.ResourceData, m interface{}, withDates bool) error {
+	clients := m.(*config.AggregatedClient)
+	node, err := getClassificationNodeByID(clients, d.Get("project_id").(string), d.Id())
+	if err != nil {
+		return err
+	}
+	return flattenClassificationNode(d, node, withDates)
+}
+
+// Moves the node first if its parent has changed, then applies renames and date changes at the new location
+func resourceClassificationNodeUpdate(d *schema.ResourceData, m interface{}, structureGroup workitemtracking.TreeStructureGroup, withDates bool) error {
+	clients := m.(*config.AggregatedClient)
+	project := converter.String(d.Get("project_id").(string))
+	nodeID, err := strconv.Atoi(d.Id())
+	if err != nil {
+		return fmt.Errorf("Invalid node ID %s: %+v", d.Id(), err)
+	}
+
+	if d.HasChange("parent_path") {
+		_, err := clients.WorkItemTrackingClient.CreateOrUpdateClassificationNode(clients.Ctx, workitemtracking.CreateOrUpdateClassificationNodeArgs{
+			Project:        project,
+			StructureGroup: &structureGroup,
+			Path:           classificationPathArg(d.Get("parent_path").(string)),
+			PostedNode:     &workitemtracking.WorkItemClassificationNode{Id: &nodeID},
+		})
+		if err != nil {
+			return fmt.Errorf("Error moving %s node %s: %+v", structureGroup, d.Id(), err)
+		}
+	}
+
+	if d.HasChange("name") || d.HasChange("start_date") || d.HasChange("finish_date") {
+		// the node is addressed by its path, which is looked up as the node may have been moved
+		current, err := getClassificationNodeByID(clients, *project, d.Id())
+		if err != nil {
+			return err
+		}
+
+		node, err := expandClassificationNode(d)
+		if err != nil {
+			return err
+		}
+
+		_, err = clients.WorkItemTrackingClient.UpdateClassificationNode(clients.Ctx, workitemtracking.UpdateClassificationNodeArgs{
+			Project:        project,
+			StructureGroup: &structureGroup,
+			Path:           classificationPathArg(getClassificationRelativePath(converter.ToString(current.Path, ""))),
+			PostedNode:     node,
+		})
+		if err != nil {
+			return fmt.Errorf("Error updating %s node %s: %+v", structureGroup, d.Id(), err)
+		}
+	}
+
+	return resourceClassificationNodeRead(d, m, withDates)
+}
+
+// Deletes the node. Work items assigned to the node are reclassified to the configured node, or to the parent node by default.
+func resourceClassificationNodeDelete(d *schema.ResourceData, m interface{}, structureGroup workitemtracking.TreeStructureGroup) error {
+	clients := m.(*config.AggregatedClient)
+	projectID := d.Get("project_id").(string)
+
+	node, err := getClassificationNodeByID(clients, projectID, d.Id())
+	if err != nil {
+		return err
+	}
+	relativePath := getClassificationRelativePath(converter.ToString(node.Path, ""))
+
+	reclassifyID := d.Get("reclassify_node_id").(int)
+	if reclassifyID == 0 {
+		parent, err := clients.WorkItemTrackingClient.GetClassificationNode(clients.Ctx, workitemtracking.GetClassificationNodeArgs{
+			Project:        converter.String(projectID),
+			StructureGroup: &structureGroup,
+			Path:           classificationPathArg(getClassificationParentPath(relativePath)),
+		})
+		if err != nil {
+			return fmt.Errorf("Error reading parent of %s node %s: %+v", structureGroup, d.Id(), err)
+		}
+		reclassifyID = *parent.Id
+	}
+
+	err = clients.WorkItemTrackingClient.DeleteClassificationNode(clients.Ctx, workitemtracking.DeleteClassificationNodeArgs{
+		Project:        converter.String(projectID),
+		StructureGroup: &structureGroup,
+		Path:           converter.String(relativePath),
+		ReclassifyId:   &reclassifyID,
+	})
+	if err != nil {
+		return fmt.Errorf("Error deleting %s node %s: %+v", structureGroup, d.Id(), err)
+	}
+
+	d.SetId("")
+	return nil
+}
+
+// Imports a node by its project and integer ID, e.g. <projectId>/<nodeId>
+func importClassificationNode(d *schema.ResourceData, m interface{}) ([]*schema.ResourceData, error) {
+	parts := strings.SplitN(d.Id(), "/", 2)
+	if len(parts) != 2 || parts[0] == "" || parts[1] == "" {
+		return nil, fmt.Errorf("Unexpected format of ID (%s), expected projectid/nodeId", d.Id())
+	}
+	if _, err := strconv.Atoi(parts[1]); err != nil {
+		return nil, fmt.Errorf("Node ID (%s) is not an integer", parts[1])
+	}
+
+	d.Set("project_id", parts[0])
+	d.SetId(parts[1])
+	return []*schema.ResourceData{d}, nil
+}
+
+func getClassificationNodeByID(clients *config.AggregatedClient, projectID string, id string) (*workitemtracking.WorkItemClassificationNode, error) {
+	nodeID, err := strconv.Atoi(id)
+	if err != nil {
+		return nil, fmt.Errorf("Invalid node ID %s: %+v", id, err)
+	}
+
+	nodes, err := clients.WorkItemTrackingClient.GetClassificationNodes(clients.Ctx, workitemtracking.GetClassificationNodesArgs{
+		Project: converter.String(projectID),
+		Ids:     &[]int{nodeID},
+	})
+	if err != nil {
+		return nil, fmt.Errorf("Error reading classification node %s: %+v", id, err)
+	}
+	if nodes == nil || len(*nodes) != 1 {
+		return nil, fmt.Errorf("Classification node %s was not found in project %s", id, projectID)
+	}
+	return &(*nodes)[0], nil
+}
+
+func expandClassificationNode(d *schema.ResourceData) (*workitemtracking.WorkItemClassificationNode, error) {
+	node := workitemtracking.WorkItemClassificationNode{
+		Name: converter.String(d.Get("name").(string)),
+	}
+
+	startDate, hasStartDate := d.GetOk("start_date")
+	finishDate, hasFinishDate := d.GetOk("finish_date")
+	if hasStartDate != hasFinishDate {
+		return nil, fmt.Errorf("start_date and finish_date must be set together")
+	}
+	if hasStartDate {
+		start, _ := time.Parse(classificationNodeDateFormat, startDate.(string))
+		finish, _ := time.Parse(classificationNodeDateFormat, finishDate.(string))
+		if finish.Before(start) {
+			return nil, fmt.Errorf("finish_date (%s) must not be before start_date (%s)", finishDate, startDate)
+		}
+		node.Attributes = &map[string]interface{}{
+			"startDate":  start.Format(time.RFC3339),
+			"finishDate": finish.Format(time.RFC3339),
+		}
+	} else if d.HasChange("start_date") || d.HasChange("finish_date") {
+		// the service keeps the dates of a node if no attributes are sent, so removed dates are cleared explicitly
+		node.Attributes = &map[string]interface{}{
+			"startDate":  nil,
+			"finishDate": nil,
+		}
+	}
+	return &node, nil
+}
+
+func flattenClassificationNode(d *schema.ResourceData, node *workitemtracking.WorkItemClassificationNode, withDates bool) error {
+	relativePath := getClassificationRelativePath(converter.ToString(node.Path, ""))
+
+	d.Set("name", converter.ToString(node.Name, ""))
+	d.Set("parent_path", getClassificationParentPath(relativePath))
+	d.Set("node_id", *node.Id)
+	d.Set("path", converter.ToString(node.Path, ""))
+	d.Set("has_children", converter.ToBool(node.HasChildren, false))
+	if node.Identifier != nil {
+		d.Set("identifier", node.Identifier.String())
+	}
+
+	if withDates {
+		var attributes map[string]interface{}
+		if node.Attributes != nil {
+			attributes = *node.Attributes
+		}
+		startDate, err := flattenClassificationNodeDate(attributes["startDate"])
+		if err != nil {
+			return err
+		}
+		finishDate, err := flattenClassificationNodeDate(attributes["finishDate"])
+		if err != nil {
+			return err
+		}
+		d.Set("start_date", startDate)
+		d.Set("finish_date", finishDate)
+	}
+	return nil
+}
+
+func flattenClassificationNodeDate(value interface{}) (string, error) {
+	if value == nil {
+		return "", nil
+	}
+	date, err := time.Parse(time.RFC3339, fmt.Sprintf("%v", value))
+	if err != nil {
+		return "", fmt.Errorf("Unexpected date %v: %+v", value, err)
+	}
+	return date.Format(classificationNodeDateFormat), nil
+}
+
+func validateClassificationNodeDate(i interface{}, key string) (_ []string, errors []error) {
+	if _, err := time.Parse(classificationNodeDateFormat, i.(string)); err != nil {
+		errors = append(errors, fmt.Errorf("%q must be a date of the form YYYY-MM-DD, got %q", key, i.(string)))
+	}
+	return nil, errors
+}
+
+// The service returns node paths of the form \<project>\<Area|Iteration>\<node>\...; this returns
+// the path of the node below the root node of the tree, e.g. <node>\...
+func getClassificationRelativePath(path string) string {
+	segments := strings.Split(strings.Trim(path, "\\"), "\\")
+	if len(segments) <= 2 {
+		return ""
+	}
+	return strings.Join(segments[2:], "\\")
+}
+
+func getClassificationParentPath(relativePath string) string {
+	if i := strings.LastIndex(relativePath, "\\"); i >= 0 {
+		return relativePath[:i]
+	}
+	return ""
+}
+
+// An empty path addresses the root node of the tree, which is requested by omitting the path
+func classificationPathArg(path string) *string {
+	path = normalizeClassificationPath(path)
+	if path == "" {
+		return nil
+	}
+	return &path
+}
+
+func normalizeClassificationPath(path string) string {
+	return strings.Trim(strings.Replace(path, "/", "\\", -1), "\\")
+}
+
+func suppressClassificationPathDiff(k, old, new string, d *schema.ResourceData) bool {
+	return strings.EqualFold(normalizeClassificationPath(old), normalizeClassificationPath(new))
+}
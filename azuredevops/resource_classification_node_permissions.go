@@ -0,0 +1,107 @@
+package azuredevops
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/google/uuid"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/microsoft/azure-devops-go-api/azuredevops/workitemtracking"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/utils/config"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/utils/converter"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/utils/validate"
+)
+
+// Permissions on area paths are managed in the CSS security namespace, permissions on iteration paths in the Iteration security namespace
+var areaSecurityNamespaceID = uuid.MustParse("83e28ad4-2d72-4ceb-97b0-c7726d5502c3")
+var iterationSecurityNamespaceID = uuid.MustParse("bf7bfa03-b2b7-47db-8113-fa2e002cc5b1")
+
+// genClassificationNodePermissionsResource creates the resource managing the permissions of a principal on a node
+// of the area or iteration tree of a project
+func genClassificationNodePermissionsResource(structureGroup workitemtracking.TreeStructureGroup, namespaceID uuid.UUID) *schema.Resource {
+	return genSecurityPermissionsResource(namespaceID, map[string]*schema.Schema{
+		"project_id": {
+			Type:         schema.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: validate.UUID,
+		},
+		"path": {
+			Type:             schema.TypeString,
+			Optional:         true,
+			ForceNew:         true,
+			Default:          "",
+			DiffSuppressFunc: suppressClassificationPathDiff,
+		},
+	}, func(d *schema.ResourceData, clients *config.AggregatedClient) (string, error) {
+		return createClassificationNodeSecurityToken(d, clients, structureGroup)
+	}, parseClassificationNodePermissionsImportID)
+}
+
+// Builds the token of a classification node, which is the chain of the identifiers of all nodes from the root node
+// to the node, e.g. vstfs:///Classification/Node/<root identifier>:vstfs:///Classification/Node/<child identifier>
+func createClassificationNodeSecurityToken(d *schema.ResourceData, clients *config.AggregatedClient, structureGroup workitemtracking.TreeStructureGroup) (string, error) {
+	path := normalizeClassificationPath(d.Get("path").(string))
+	var segments []string
+	if path != "" {
+		segments = strings.Split(path, "\\")
+	}
+
+	node, err := clients.WorkItemTrackingClient.GetClassificationNode(clients.Ctx, workitemtracking.GetClassificationNodeArgs{
+		Project:        converter.String(d.Get("project_id").(string)),
+		StructureGroup: &structureGroup,
+		Depth:          converter.Int(len(segments)),
+	})
+	if err != nil {
+		return "", fmt.Errorf("Error reading %s of project %s: %+v", structureGroup, d.Get("project_id").(string), err)
+	}
+
+	identifiers := []string{getClassificationNodeSecurityIdentifier(node)}
+	for _, segment := range segments {
+		node = findClassificationChildNode(node, segment)
+		if node == nil {
+			return "", &securityTargetNotFoundError{fmt.Sprintf("Path %s does not exist in the %s of project %s", path, structureGroup, d.Get("project_id").(string))}
+		}
+		identifiers = append(identifiers, getClassificationNodeSecurityIdentifier(node))
+	}
+	return strings.Join(identifiers, ":"), nil
+}
+
+func getClassificationNodeSecurityIdentifier(node *workitemtracking.WorkItemClassificationNode) string {
+	identifier := ""
+	if node.Identifier != nil {
+		identifier = node.Identifier.String()
+	}
+	return "vstfs:///Classification/Node/" + identifier
+}
+
+func findClassificationChildNode(node *workitemtracking.WorkItemClassificationNode, name string) *workitemtracking.WorkItemClassificationNode {
+	if node.Children == nil {
+		return nil
+	}
+	for _, child := range *node.Children {
+		if strings.EqualFold(converter.ToString(child.Name, ""), name) {
+			return &child
+		}
+	}
+	return nil
+}
+
+// Import ID is of the form <project ID>/<path>/<principal descriptor>. The path may contain slashes and is empty
+// for the root node, e.g. 782a8123-1019-xxxx-xxxx-xxxxxxxx//vssgp.xxx
+func parseClassificationNodePermissionsImportID(d *schema.ResourceData) error {
+	id := d.Id()
+	first := strings.Index(id, "/")
+	last := strings.LastIndex(id, "/")
+	if first <= 0 || last <= first || last == len(id)-1 {
+		return fmt.Errorf("Error parsing import ID %s. Expected format <project ID>/<path>/<principal descriptor>", id)
+	}
+	if _, err := uuid.Parse(id[:first]); err != nil {
+		return fmt.Errorf("Error parsing import ID %s. Project ID %s is not a valid UUID", id, id[:first])
+	}
+
+	d.Set("project_id", id[:first])
+	d.Set("path", normalizeClassificationPath(id[first+1:last]))
+	d.Set("principal", id[last+1:])
+	return nil
+}
@@ -0,0 +1,191 @@
+// +build all security resource_area_permissions resource_iteration_permissions
+
+package azuredevops
+
+// The tests in this file use the mock clients in mock_client.go to mock out
+// the Azure DevOps client operations.
+
+import (
+	"context"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/google/uuid"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/microsoft/azure-devops-go-api/azuredevops/workitemtracking"
+	"github.com/microsoft/terraform-provider-azuredevops/azdosdkmocks"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/utils/config"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/utils/converter"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/utils/testhelper"
+	"github.com/stretchr/testify/require"
+)
+
+var testNodePermissionsProjectID = uuid.New()
+var testRootAreaIdentifier = uuid.New()
+var testParentAreaIdentifier = uuid.New()
+var testChildAreaIdentifier = uuid.New()
+
+var testAreaTree = workitemtracking.WorkItemClassificationNode{
+	Identifier: &testRootAreaIdentifier,
+	Name:       converter.String("Project"),
+	Children: &[]workitemtracking.WorkItemClassificationNode{
+		{
+			Identifier: &testParentAreaIdentifier,
+			Name:       converter.String("Platform"),
+			Children: &[]workitemtracking.WorkItemClassificationNode{
+				{Identifier: &testChildAreaIdentifier, Name: converter.String("Services")},
+			},
+		},
+	},
+}
+
+/**
+ * Begin unit tests
+ */
+
+// verifies that the token of an area is the chain of the identifiers from the root area to the area
+func TestAzureDevOpsAreaPermissions_CreateToken(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	witClient := azdosdkmocks.NewMockWorkitemtrackingClient(ctrl)
+	clients := &config.AggregatedClient{
+		WorkItemTrackingClient: witClient,
+		Ctx:                    context.Background(),
+	}
+
+	resourceData := schema.TestResourceDataRaw(t, resourceAreaPermissions().Schema, nil)
+	resourceData.Set("project_id", testNodePermissionsProjectID.String())
+	resourceData.Set("path", "platform/services")
+
+	witClient.
+		EXPECT().
+		GetClassificationNode(clients.Ctx, workitemtracking.GetClassificationNodeArgs{
+			Project:        converter.String(testNodePermissionsProjectID.String()),
+			StructureGroup: &workitemtracking.TreeStructureGroupValues.Areas,
+			Depth:          converter.Int(2),
+		}).
+		Return(&testAreaTree, nil).
+		Times(2)
+
+	token, err := createClassificationNodeSecurityToken(resourceData, clients, workitemtracking.TreeStructureGroupValues.Areas)
+	require.Nil(t, err)
+	require.Equal(t, "vstfs:///Classification/Node/"+testRootAreaIdentifier.String()+
+		":vstfs:///Classification/Node/"+testParentAreaIdentifier.String()+
+		":vstfs:///Classification/Node/"+testChildAreaIdentifier.String(), token)
+
+	resourceData.Set("path", "Platform\\Frontend")
+	_, err = createClassificationNodeSecurityToken(resourceData, clients, workitemtracking.TreeStructureGroupValues.Areas)
+	require.Contains(t, err.Error(), "does not exist")
+}
+
+// verifies that the token of the root node only contains the identifier of the root node
+func TestAzureDevOpsIterationPermissions_CreateToken_Root(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	witClient := azdosdkmocks.NewMockWorkitemtrackingClient(ctrl)
+	clients := &config.AggregatedClient{
+		WorkItemTrackingClient: witClient,
+		Ctx:                    context.Background(),
+	}
+
+	resourceData := schema.TestResourceDataRaw(t, resourceIterationPermissions().Schema, nil)
+	resourceData.Set("project_id", testNodePermissionsProjectID.String())
+
+	witClient.
+		EXPECT().
+		GetClassificationNode(clients.Ctx, workitemtracking.GetClassificationNodeArgs{
+			Project:        converter.String(testNodePermissionsProjectID.String()),
+			StructureGroup: &workitemtracking.TreeStructureGroupValues.Iterations,
+			Depth:          converter.Int(0),
+		}).
+		Return(&workitemtracking.WorkItemClassificationNode{Identifier: &testRootAreaIdentifier}, nil).
+		Times(1)
+
+	token, err := createClassificationNodeSecurityToken(resourceData, clients, workitemtracking.TreeStructureGroupValues.Iterations)
+	require.Nil(t, err)
+	require.Equal(t, "vstfs:///Classification/Node/"+testRootAreaIdentifier.String(), token)
+}
+
+// verifies that the permissions are removed from the state if the area does not exist anymore
+func TestAzureDevOpsAreaPermissions_Read_RemovesDeletedArea(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	witClient := azdosdkmocks.NewMockWorkitemtrackingClient(ctrl)
+	clients := &config.AggregatedClient{
+		WorkItemTrackingClient: witClient,
+		Ctx:                    context.Background(),
+	}
+
+	resourceData := schema.TestResourceDataRaw(t, resourceAreaPermissions().Schema, nil)
+	resourceData.SetId("id")
+	resourceData.Set("project_id", testNodePermissionsProjectID.String())
+	resourceData.Set("path", "Platform\\Frontend")
+
+	witClient.
+		EXPECT().
+		GetClassificationNode(clients.Ctx, gomock.Any()).
+		Return(&testAreaTree, nil).
+		Times(1)
+
+	err := resourceAreaPermissions().Read(resourceData, clients)
+	require.Nil(t, err)
+	require.Equal(t, "", resourceData.Id())
+}
+
+// verifies that the import ID is split into project, path and principal
+func TestAzureDevOpsAreaPermissions_Import_ParsesID(t *testing.T) {
+	resourceData := schema.TestResourceDataRaw(t, resourceAreaPermissions().Schema, nil)
+	resourceData.SetId(testNodePermissionsProjectID.String() + "/Platform/Services/vssgp.readers")
+
+	err := parseClassificationNodePermissionsImportID(resourceData)
+	require.Nil(t, err)
+	require.Equal(t, testNodePermissionsProjectID.String(), resourceData.Get("project_id"))
+	require.Equal(t, "Platform\\Services", resourceData.Get("path"))
+	require.Equal(t, "vssgp.readers", resourceData.Get("principal"))
+
+	resourceData.SetId(testNodePermissionsProjectID.String() + "//vssgp.readers")
+	err = parseClassificationNodePermissionsImportID(resourceData)
+	require.Nil(t, err)
+	require.Equal(t, "", resourceData.Get("path"))
+}
+
+/**
+ * Begin acceptance tests
+ */
+
+// Verifies that the permissions of a group on an area and an iteration can be set and changed
+func TestAccAzureDevOpsClassificationNodePermissions_CreateAndUpdate(t *testing.T) {
+	projectName := testhelper.TestAccResourcePrefix + acctest.RandStringFromCharSet(10, acctest.CharSetAlphaNum)
+	areaNode := "azuredevops_area_permissions.area"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testhelper.TestAccPreCheck(t, nil) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccProjectCheckDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testhelper.TestAccClassificationNodePermissionsResources(projectName, permissionDeny),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(areaNode, "permissions.WORK_ITEM_READ", permissionAllow),
+					resource.TestCheckResourceAttr(areaNode, "permissions.WORK_ITEM_WRITE", permissionDeny),
+					resource.TestCheckResourceAttr("azuredevops_iteration_permissions.iteration", "permissions.CREATE_CHILDREN", permissionDeny),
+				),
+			},
+			{
+				Config: testhelper.TestAccClassificationNodePermissionsResources(projectName, permissionAllow),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(areaNode, "permissions.WORK_ITEM_WRITE", permissionAllow),
+				),
+			},
+		},
+	})
+}
+
+func init() {
+	InitProvider()
+}
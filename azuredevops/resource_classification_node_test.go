@@ -0,0 +1,232 @@
+// +build all workitemtracking resource_classification_node
+
+package azuredevops
+
+// The tests in this file use the mock clients in mock_client.go to mock out
+// the Azure DevOps client operations.
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strconv"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/google/uuid"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/terraform"
+	"github.com/microsoft/azure-devops-go-api/azuredevops/workitemtracking"
+	"github.com/microsoft/terraform-provider-azuredevops/azdosdkmocks"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/utils/config"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/utils/converter"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/utils/testhelper"
+	"github.com/stretchr/testify/require"
+)
+
+var testClassificationNodeProjectID = uuid.New()
+
+var testIterationNode = workitemtracking.WorkItemClassificationNode{
+	Id:   converter.Int(42),
+	Name: converter.String("Sprint 1"),
+	Path: converter.String("\\Project\\Iteration\\Release 1\\Sprint 1"),
+	Attributes: &map[string]interface{}{
+		"startDate":  "2020-01-06T00:00:00Z",
+		"finishDate": "2020-01-17T00:00:00Z",
+	},
+}
+
+/**
+ * Begin unit tests
+ */
+
+// verifies that the paths returned by the service are converted into paths relative to the root node
+func TestAzureDevOpsClassificationNode_Paths(t *testing.T) {
+	require.Equal(t, "Release 1\\Sprint 1", getClassificationRelativePath("\\Project\\Iteration\\Release 1\\Sprint 1"))
+	require.Equal(t, "", getClassificationRelativePath("\\Project\\Iteration"))
+	require.Equal(t, "Release 1", getClassificationParentPath("Release 1\\Sprint 1"))
+	require.Equal(t, "", getClassificationParentPath("Release 1"))
+	require.Nil(t, classificationPathArg("/"))
+	require.Equal(t, "Release 1\\Sprint 1", *classificationPathArg("/Release 1/Sprint 1"))
+	require.True(t, suppressClassificationPathDiff("parent_path", "Release 1\\Sprint 1", "release 1/sprint 1", nil))
+}
+
+// verifies that the dates of an iteration are sent as attributes and read back as dates
+func TestAzureDevOpsClassificationNode_ExpandFlatten_Dates(t *testing.T) {
+	resourceData := schema.TestResourceDataRaw(t, resourceIteration().Schema, nil)
+	err := flattenClassificationNode(resourceData, &testIterationNode, true)
+	require.Nil(t, err)
+	require.Equal(t, "2020-01-06", resourceData.Get("start_date"))
+	require.Equal(t, "2020-01-17", resourceData.Get("finish_date"))
+	require.Equal(t, "Release 1", resourceData.Get("parent_path"))
+
+	node, err := expandClassificationNode(resourceData)
+	require.Nil(t, err)
+	require.Equal(t, *testIterationNode.Attributes, *node.Attributes)
+
+	resourceData.Set("finish_date", "")
+	_, err = expandClassificationNode(resourceData)
+	require.NotNil(t, err)
+}
+
+// verifies that dates removed from the configuration are cleared instead of being left unchanged
+func TestAzureDevOpsClassificationNode_Expand_ClearsRemovedDates(t *testing.T) {
+	r := resourceIteration()
+	nodeConfig := map[string]interface{}{
+		"project_id":  testClassificationNodeProjectID.String(),
+		"name":        "Sprint 1",
+		"start_date":  "2020-01-06",
+		"finish_date": "2020-01-17",
+	}
+	stateData := schema.TestResourceDataRaw(t, r.Schema, nodeConfig)
+	stateData.SetId("42")
+	state := stateData.State()
+
+	delete(nodeConfig, "start_date")
+	delete(nodeConfig, "finish_date")
+	diff, err := r.Diff(state, terraform.NewResourceConfigRaw(nodeConfig), nil)
+	require.Nil(t, err)
+	resourceData, err := schema.InternalMap(r.Schema).Data(state, diff)
+	require.Nil(t, err)
+
+	node, err := expandClassificationNode(resourceData)
+	require.Nil(t, err)
+	require.Equal(t, map[string]interface{}{"startDate": nil, "finishDate": nil}, *node.Attributes)
+
+	// the dates are not sent for nodes that never had dates
+	node, err = expandClassificationNode(schema.TestResourceDataRaw(t, r.Schema, nil))
+	require.Nil(t, err)
+	require.Nil(t, node.Attributes)
+}
+
+// verifies that the create operation creates the node below its parent and does not swallow errors
+func TestAzureDevOpsClassificationNode_Create_DoesNotSwallowError(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	witClient := azdosdkmocks.NewMockWorkitemtrackingClient(ctrl)
+	clients := &config.AggregatedClient{
+		WorkItemTrackingClient: witClient,
+		Ctx:                    context.Background(),
+	}
+
+	resourceData := schema.TestResourceDataRaw(t, resourceArea().Schema, nil)
+	resourceData.Set("project_id", testClassificationNodeProjectID.String())
+	resourceData.Set("name", "Services")
+	resourceData.Set("parent_path", "Platform")
+
+	witClient.
+		EXPECT().
+		CreateOrUpdateClassificationNode(clients.Ctx, workitemtracking.CreateOrUpdateClassificationNodeArgs{
+			Project:        converter.String(testClassificationNodeProjectID.String()),
+			StructureGroup: &workitemtracking.TreeStructureGroupValues.Areas,
+			Path:           converter.String("Platform"),
+			PostedNode:     &workitemtracking.WorkItemClassificationNode{Name: converter.String("Services")},
+		}).
+		Return(nil, errors.New("CreateOrUpdateClassificationNode() Failed")).
+		Times(1)
+
+	err := resourceArea().Create(resourceData, clients)
+	require.Contains(t, err.Error(), "CreateOrUpdateClassificationNode() Failed")
+}
+
+// verifies that nodes are reclassified to their parent when no reclassification node is configured
+func TestAzureDevOpsClassificationNode_Delete_ReclassifiesToParent(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	witClient := azdosdkmocks.NewMockWorkitemtrackingClient(ctrl)
+	clients := &config.AggregatedClient{
+		WorkItemTrackingClient: witClient,
+		Ctx:                    context.Background(),
+	}
+
+	resourceData := schema.TestResourceDataRaw(t, resourceIteration().Schema, nil)
+	resourceData.Set("project_id", testClassificationNodeProjectID.String())
+	resourceData.SetId(strconv.Itoa(*testIterationNode.Id))
+
+	witClient.
+		EXPECT().
+		GetClassificationNodes(clients.Ctx, workitemtracking.GetClassificationNodesArgs{
+			Project: converter.String(testClassificationNodeProjectID.String()),
+			Ids:     &[]int{42},
+		}).
+		Return(&[]workitemtracking.WorkItemClassificationNode{testIterationNode}, nil).
+		Times(1)
+	witClient.
+		EXPECT().
+		GetClassificationNode(clients.Ctx, workitemtracking.GetClassificationNodeArgs{
+			Project:        converter.String(testClassificationNodeProjectID.String()),
+			StructureGroup: &workitemtracking.TreeStructureGroupValues.Iterations,
+			Path:           converter.String("Release 1"),
+		}).
+		Return(&workitemtracking.WorkItemClassificationNode{Id: converter.Int(7)}, nil).
+		Times(1)
+	witClient.
+		EXPECT().
+		DeleteClassificationNode(clients.Ctx, workitemtracking.DeleteClassificationNodeArgs{
+			Project:        converter.String(testClassificationNodeProjectID.String()),
+			StructureGroup: &workitemtracking.TreeStructureGroupValues.Iterations,
+			Path:           converter.String("Release 1\\Sprint 1"),
+			ReclassifyId:   converter.Int(7),
+		}).
+		Return(nil).
+		Times(1)
+
+	err := resourceIteration().Delete(resourceData, clients)
+	require.Nil(t, err)
+	require.Equal(t, "", resourceData.Id())
+}
+
+/**
+ * Begin acceptance tests
+ */
+
+// Verifies that nested areas and iterations can be created and that iterations can be renamed and rescheduled
+func TestAccAzureDevOpsClassificationNode_CreateAndUpdate(t *testing.T) {
+	projectName := testhelper.TestAccResourcePrefix + acctest.RandStringFromCharSet(10, acctest.CharSetAlphaNum)
+	iterationNode := "azuredevops_iteration.iteration"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testhelper.TestAccPreCheck(t, nil) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccProjectCheckDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testhelper.TestAccClassificationNodeResources(projectName, "Sprint 1", "2020-01-17"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("azuredevops_area.area", "parent_path", "Platform"),
+					resource.TestCheckResourceAttrSet("azuredevops_area.area", "identifier"),
+					resource.TestCheckResourceAttr(iterationNode, "name", "Sprint 1"),
+					resource.TestCheckResourceAttr(iterationNode, "finish_date", "2020-01-17"),
+				),
+			},
+			{
+				Config: testhelper.TestAccClassificationNodeResources(projectName, "Sprint One", "2020-01-24"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(iterationNode, "name", "Sprint One"),
+					resource.TestCheckResourceAttr(iterationNode, "finish_date", "2020-01-24"),
+				),
+			},
+			{
+				ResourceName:      iterationNode,
+				ImportStateIdFunc: testAccClassificationNodeImportStateIDFunc(iterationNode),
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccClassificationNodeImportStateIDFunc(resourceName string) resource.ImportStateIdFunc {
+	return func(s *terraform.State) (string, error) {
+		res := s.RootModule().Resources[resourceName]
+		return fmt.Sprintf("%s/%s", res.Primary.Attributes["project_id"], res.Primary.ID), nil
+	}
+}
+
+func init() {
+	InitProvider()
+}
@@ -0,0 +1,274 @@
+package azuredevops
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/microsoft/azure-devops-go-api/azuredevops/git"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/utils/config"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/utils/converter"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/utils/validate"
+)
+
+// Object ID used as old object ID to create a ref and as new object ID to delete a ref
+const gitEmptyObjectID = "0000000000000000000000000000000000000000"
+
+func resourceGitBranch() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceGitBranchCreate,
+		Read:   resourceGitBranchRead,
+		Update: resourceGitBranchUpdate,
+		Delete: resourceGitBranchDelete,
+		Importer: &schema.ResourceImporter{
+			State: importGitBranch,
+		},
+		Schema: map[string]*schema.Schema{
+			"repository_id": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validate.UUID,
+			},
+			"name": {
+				Type:             schema.TypeString,
+				Required:         true,
+				ForceNew:         true,
+				ValidateFunc:     validate.NoEmptyStrings,
+				DiffSuppressFunc: suppressBranchNamePrefixDiff,
+			},
+			"ref_branch": {
+				Type:             schema.TypeString,
+				Optional:         true,
+				ForceNew:         true,
+				ValidateFunc:     validate.NoEmptyStrings,
+				DiffSuppressFunc: suppressGitRefSourceDiff,
+				ConflictsWith:    []string{"ref_tag", "ref_commit_id"},
+			},
+			"ref_tag": {
+				Type:             schema.TypeString,
+				Optional:         true,
+				ForceNew:         true,
+				ValidateFunc:     validate.NoEmptyStrings,
+				DiffSuppressFunc: suppressGitRefSourceDiff,
+				ConflictsWith:    []string{"ref_branch", "ref_commit_id"},
+			},
+			"ref_commit_id": {
+				Type:             schema.TypeString,
+				Optional:         true,
+				ForceNew:         true,
+				ValidateFunc:     validate.NoEmptyStrings,
+				DiffSuppressFunc: suppressGitRefSourceDiff,
+				ConflictsWith:    []string{"ref_branch", "ref_tag"},
+			},
+			"locked": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+			"last_commit_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+// The branch, tag or commit a branch or tag is created from cannot be read back from the service, so changes to it
+// are ignored once the branch or tag exists
+func suppressGitRefSourceDiff(k, old, new string, d *schema.ResourceData) bool {
+	return d.Id() != ""
+}
+
+func resourceGitBranchCreate(d *schema.ResourceData, m interface{}) error {
+	clients := m.(*config.AggregatedClient)
+	repoID := d.Get("repository_id").(string)
+	name := withBranchPrefix(d.Get("name").(string))
+
+	objectID, err := resolveGitBranchSource(d, clients, repoID)
+	if err != nil {
+		return err
+	}
+	if err := updateGitRef(clients, repoID, name, gitEmptyObjectID, objectID); err != nil {
+		return fmt.Errorf("Error creating branch %s: %+v", name, err)
+	}
+	d.SetId(fmt.Sprintf("%s:%s", repoID, name))
+	d.Set("name", name)
+
+	if d.Get("locked").(bool) {
+		if err := lockGitBranch(clients, repoID, name, true); err != nil {
+			return err
+		}
+	}
+	return resourceGitBranchRead(d, m)
+}
+
+func resourceGitBranchRead(d *schema.ResourceData, m interface{}) error {
+	clients := m.(*config.AggregatedClient)
+	repoID := d.Get("repository_id").(string)
+	name := withBranchPrefix(d.Get("name").(string))
+
+	ref, err := getGitRef(clients, repoID, name)
+	if err != nil {
+		return err
+	}
+	// the branch has been deleted outside of Terraform
+	if ref == nil {
+		d.SetId("")
+		return nil
+	}
+
+	d.Set("locked", converter.ToBool(ref.IsLocked, false))
+	d.Set("last_commit_id", converter.ToString(ref.ObjectId, ""))
+	return nil
+}
+
+func resourceGitBranchUpdate(d *schema.ResourceData, m interface{}) error {
+	clients := m.(*config.AggregatedClient)
+	repoID := d.Get("repository_id").(string)
+	name := withBranchPrefix(d.Get("name").(string))
+
+	if d.HasChange("locked") {
+		if err := lockGitBranch(clients, repoID, name, d.Get("locked").(bool)); err != nil {
+			return err
+		}
+	}
+	return resourceGitBranchRead(d, m)
+}
+
+func resourceGitBranchDelete(d *schema.ResourceData, m interface{}) error {
+	clients := m.(*config.AggregatedClient)
+	repoID := d.Get("repository_id").(string)
+	name := withBranchPrefix(d.Get("name").(string))
+
+	ref, err := getGitRef(clients, repoID, name)
+	if err != nil {
+		return err
+	}
+	if ref == nil {
+		d.SetId("")
+		return nil
+	}
+
+	// locked branches cannot be deleted
+	if converter.ToBool(ref.IsLocked, false) {
+		if err := lockGitBranch(clients, repoID, name, false); err != nil {
+			return err
+		}
+	}
+	if err := updateGitRef(clients, repoID, name, converter.ToString(ref.ObjectId, ""), gitEmptyObjectID); err != nil {
+		return fmt.Errorf("Error deleting branch %s: %+v", name, err)
+	}
+
+	d.SetId("")
+	return nil
+}
+
+// Import ID is of the form <repository ID>:<branch>, e.g. 782a8123-1019-xxxx-xxxx-xxxxxxxx:refs/heads/release/1.0
+func importGitBranch(d *schema.ResourceData, m interface{}) ([]*schema.ResourceData, error) {
+	repoID, name, err := parseGitRefImportID(d.Id(), "refs/heads/")
+	if err != nil {
+		return nil, err
+	}
+
+	d.Set("repository_id", repoID)
+	d.Set("name", name)
+	d.SetId(fmt.Sprintf("%s:%s", repoID, name))
+	return []*schema.ResourceData{d}, nil
+}
+
+// Splits an import ID of the form <repository ID>:<ref> and adds the prefix to the ref if it is missing
+func parseGitRefImportID(id string, prefix string) (string, string, error) {
+	parts := strings.SplitN(id, ":", 2)
+	if len(parts) != 2 || parts[0] == "" || strings.TrimPrefix(parts[1], prefix) == "" {
+		return "", "", fmt.Errorf("Error parsing import ID %s. Expected format <repository ID>:%s<name>", id, prefix)
+	}
+	if !strings.HasPrefix(parts[1], prefix) {
+		parts[1] = prefix + parts[1]
+	}
+	return parts[0], parts[1], nil
+}
+
+// Looks up the commit the new branch points to, which is either given as commit ID or as the branch or tag it
+// is created from
+func resolveGitBranchSource(d *schema.ResourceData, clients *config.AggregatedClient, repoID string) (string, error) {
+	if commitID, ok := d.GetOk("ref_commit_id"); ok {
+		return commitID.(string), nil
+	}
+
+	var source string
+	if branch, ok := d.GetOk("ref_branch"); ok {
+		source = withBranchPrefix(branch.(string))
+	} else if tag, ok := d.GetOk("ref_tag"); ok {
+		source = withTagPrefix(tag.(string))
+	} else {
+		return "", fmt.Errorf("One of ref_branch, ref_tag or ref_commit_id is required to create a branch")
+	}
+	return resolveGitRefCommitID(clients, repoID, source)
+}
+
+// Reads the ID of the commit a ref points to. Annotated tags point to a tag object, so the peeled object ID is
+// used for them.
+func resolveGitRefCommitID(clients *config.AggregatedClient, repoID string, name string) (string, error) {
+	ref, err := getGitRef(clients, repoID, name)
+	if err != nil {
+		return "", err
+	}
+	if ref == nil {
+		return "", fmt.Errorf("Ref %s does not exist in repository %s", name, repoID)
+	}
+	if ref.PeeledObjectId != nil && *ref.PeeledObjectId != "" {
+		return *ref.PeeledObjectId, nil
+	}
+	return converter.ToString(ref.ObjectId, ""), nil
+}
+
+// Creates, moves or deletes a ref. The service reports rejected updates in the result instead of an error.
+func updateGitRef(clients *config.AggregatedClient, repoID string, name string, oldObjectID string, newObjectID string) error {
+	results, err := clients.GitReposClient.UpdateRefs(clients.Ctx, git.UpdateRefsArgs{
+		RepositoryId: converter.String(repoID),
+		RefUpdates: &[]git.GitRefUpdate{{
+			Name:        converter.String(name),
+			OldObjectId: converter.String(oldObjectID),
+			NewObjectId: converter.String(newObjectID),
+		}},
+	})
+	if err != nil {
+		return err
+	}
+	if results == nil || len(*results) != 1 {
+		return fmt.Errorf("Unexpected result of the update of ref %s", name)
+	}
+
+	result := (*results)[0]
+	if !converter.ToBool(result.Success, false) {
+		status := ""
+		if result.UpdateStatus != nil {
+			status = string(*result.UpdateStatus)
+		}
+		return fmt.Errorf("Update of ref %s was rejected: %s %s", name, status, converter.ToString(result.CustomMessage, ""))
+	}
+	return nil
+}
+
+func lockGitBranch(clients *config.AggregatedClient, repoID string, name string, locked bool) error {
+	_, err := clients.GitReposClient.UpdateRef(clients.Ctx, git.UpdateRefArgs{
+		RepositoryId: converter.String(repoID),
+		Filter:       converter.String(strings.TrimPrefix(name, "refs/")),
+		NewRefInfo: &git.GitRefUpdate{
+			IsLocked: converter.Bool(locked),
+		},
+	})
+	if err != nil {
+		return fmt.Errorf("Error changing lock of branch %s: %+v", name, err)
+	}
+	return nil
+}
+
+// Tag names can be configured with or without the refs/tags/ prefix
+func withTagPrefix(tagName string) string {
+	if strings.HasPrefix(tagName, "refs/tags/") {
+		return tagName
+	}
+	return "refs/tags/" + tagName
+}
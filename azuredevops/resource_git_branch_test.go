@@ -0,0 +1,223 @@
+// +build all core resource_git_branch
+
+package azuredevops
+
+// The tests in this file use the mock clients in mock_client.go to mock out
+// the Azure DevOps client operations.
+
+import (
+	"context"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/google/uuid"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/terraform"
+	"github.com/microsoft/azure-devops-go-api/azuredevops/git"
+	"github.com/microsoft/terraform-provider-azuredevops/azdosdkmocks"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/utils/config"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/utils/converter"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/utils/testhelper"
+	"github.com/stretchr/testify/require"
+)
+
+var testGitBranchRepoID = uuid.New().String()
+
+/**
+ * Begin unit tests
+ */
+
+// verifies that a branch created from an annotated tag points to the tagged commit and is locked if requested
+func TestGitBranch_Create_FromTagAndLocked(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	reposClient := azdosdkmocks.NewMockGitClient(ctrl)
+	clients := &config.AggregatedClient{GitReposClient: reposClient, Ctx: context.Background()}
+
+	resourceData := schema.TestResourceDataRaw(t, resourceGitBranch().Schema, nil)
+	resourceData.Set("repository_id", testGitBranchRepoID)
+	resourceData.Set("name", "release/1.0")
+	resourceData.Set("ref_tag", "v1.0")
+	resourceData.Set("locked", true)
+
+	reposClient.
+		EXPECT().
+		GetRefs(clients.Ctx, git.GetRefsArgs{
+			RepositoryId: converter.String(testGitBranchRepoID),
+			Filter:       converter.String("tags/v1.0"),
+			PeelTags:     converter.Bool(true),
+		}).
+		Return(&git.GetRefsResponseValue{Value: []git.GitRef{{
+			Name:           converter.String("refs/tags/v1.0"),
+			ObjectId:       converter.String("tag"),
+			PeeledObjectId: converter.String("commit"),
+		}}}, nil).
+		Times(1)
+	reposClient.
+		EXPECT().
+		UpdateRefs(clients.Ctx, git.UpdateRefsArgs{
+			RepositoryId: converter.String(testGitBranchRepoID),
+			RefUpdates: &[]git.GitRefUpdate{{
+				Name:        converter.String("refs/heads/release/1.0"),
+				OldObjectId: converter.String(gitEmptyObjectID),
+				NewObjectId: converter.String("commit"),
+			}},
+		}).
+		Return(&[]git.GitRefUpdateResult{{Success: converter.Bool(true)}}, nil).
+		Times(1)
+	reposClient.
+		EXPECT().
+		UpdateRef(clients.Ctx, git.UpdateRefArgs{
+			RepositoryId: converter.String(testGitBranchRepoID),
+			Filter:       converter.String("heads/release/1.0"),
+			NewRefInfo:   &git.GitRefUpdate{IsLocked: converter.Bool(true)},
+		}).
+		Return(&git.GitRef{}, nil).
+		Times(1)
+	reposClient.
+		EXPECT().
+		GetRefs(clients.Ctx, gomock.Any()).
+		Return(&git.GetRefsResponseValue{Value: []git.GitRef{{
+			Name:     converter.String("refs/heads/release/1.0"),
+			ObjectId: converter.String("commit"),
+			IsLocked: converter.Bool(true),
+		}}}, nil).
+		Times(1)
+
+	err := resourceGitBranchCreate(resourceData, clients)
+	require.Nil(t, err)
+	require.Equal(t, testGitBranchRepoID+":refs/heads/release/1.0", resourceData.Id())
+	require.Equal(t, "commit", resourceData.Get("last_commit_id"))
+	require.True(t, resourceData.Get("locked").(bool))
+}
+
+// verifies that a rejected ref update is reported as error
+func TestGitBranch_Create_ReportsRejectedUpdate(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	reposClient := azdosdkmocks.NewMockGitClient(ctrl)
+	clients := &config.AggregatedClient{GitReposClient: reposClient, Ctx: context.Background()}
+
+	resourceData := schema.TestResourceDataRaw(t, resourceGitBranch().Schema, nil)
+	resourceData.Set("repository_id", testGitBranchRepoID)
+	resourceData.Set("name", "release/1.0")
+	resourceData.Set("ref_commit_id", "commit")
+
+	reposClient.
+		EXPECT().
+		UpdateRefs(clients.Ctx, gomock.Any()).
+		Return(&[]git.GitRefUpdateResult{{
+			Success:      converter.Bool(false),
+			UpdateStatus: &git.GitRefUpdateStatusValues.CreateBranchPermissionRequired,
+		}}, nil).
+		Times(1)
+
+	err := resourceGitBranchCreate(resourceData, clients)
+	require.Contains(t, err.Error(), "Update of ref refs/heads/release/1.0 was rejected: createBranchPermissionRequired")
+	require.Equal(t, "", resourceData.Id())
+}
+
+// verifies that a branch requires a source
+func TestGitBranch_Create_RequiresSource(t *testing.T) {
+	resourceData := schema.TestResourceDataRaw(t, resourceGitBranch().Schema, nil)
+	resourceData.Set("repository_id", testGitBranchRepoID)
+	resourceData.Set("name", "release/1.0")
+
+	err := resourceGitBranchCreate(resourceData, &config.AggregatedClient{Ctx: context.Background()})
+	require.Equal(t, "One of ref_branch, ref_tag or ref_commit_id is required to create a branch", err.Error())
+}
+
+// verifies that the import ID is split into repository and branch
+func TestGitBranch_Import_ParsesID(t *testing.T) {
+	resourceData := schema.TestResourceDataRaw(t, resourceGitBranch().Schema, nil)
+	resourceData.SetId(testGitBranchRepoID + ":refs/heads/release/1.0")
+
+	_, err := importGitBranch(resourceData, nil)
+	require.Nil(t, err)
+	require.Equal(t, testGitBranchRepoID, resourceData.Get("repository_id"))
+	require.Equal(t, "refs/heads/release/1.0", resourceData.Get("name"))
+
+	resourceData.SetId(testGitBranchRepoID + ":refs/heads/")
+	_, err = importGitBranch(resourceData, nil)
+	require.NotNil(t, err)
+}
+
+// verifies that the source of an existing branch, which is not set after an import, does not replace the branch
+func TestGitBranch_Diff_IgnoresSourceOfExistingBranch(t *testing.T) {
+	state := &terraform.InstanceState{
+		ID: testGitBranchRepoID + ":refs/heads/release/1.0",
+		Attributes: map[string]string{
+			"repository_id": testGitBranchRepoID,
+			"name":          "refs/heads/release/1.0",
+			"locked":        "false",
+		},
+	}
+	config := terraform.NewResourceConfigRaw(map[string]interface{}{
+		"repository_id": testGitBranchRepoID,
+		"name":          "release/1.0",
+		"ref_branch":    "master",
+	})
+
+	diff, err := resourceGitBranch().Diff(state, config, nil)
+	require.Nil(t, err)
+	require.True(t, diff == nil || diff.Empty())
+
+	diff, err = resourceGitBranch().Diff(nil, config, nil)
+	require.Nil(t, err)
+	require.Equal(t, "master", diff.Attributes["ref_branch"].New)
+}
+
+/**
+ * Begin acceptance tests
+ */
+
+// Verifies that a branch and a tag can be created from the master branch, and that the branch can be locked
+func TestAccGitBranchAndTag_CreateAndUpdate(t *testing.T) {
+	projectName := testhelper.TestAccResourcePrefix + acctest.RandStringFromCharSet(10, acctest.CharSetAlphaNum)
+	gitRepoName := testhelper.TestAccResourcePrefix + acctest.RandStringFromCharSet(10, acctest.CharSetAlphaNum)
+	tfBranchNode := "azuredevops_git_branch.branch"
+	tfTagNode := "azuredevops_git_tag.tag"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testhelper.TestAccPreCheck(t, nil) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccAzureGitRepoCheckDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testhelper.TestAccGitBranchAndTagResources(projectName, gitRepoName, false),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(tfBranchNode, "locked", "false"),
+					resource.TestCheckResourceAttrSet(tfBranchNode, "last_commit_id"),
+					resource.TestCheckResourceAttr(tfTagNode, "message", "Release 1.0"),
+					resource.TestCheckResourceAttrPair(tfTagNode, "commit_id", tfBranchNode, "last_commit_id"),
+				),
+			},
+			{
+				Config: testhelper.TestAccGitBranchAndTagResources(projectName, gitRepoName, true),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(tfBranchNode, "locked", "true"),
+				),
+			},
+			{
+				ResourceName:            tfBranchNode,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"ref_branch"},
+			},
+			{
+				ResourceName:            tfTagNode,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"ref_branch"},
+			},
+		},
+	})
+}
+
+func init() {
+	InitProvider()
+}
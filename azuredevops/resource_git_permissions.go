@@ -0,0 +1,109 @@
+package azuredevops
+
+import (
+	"encoding/binary"
+	"encoding/hex"
+	"fmt"
+	"strings"
+	"unicode/utf16"
+
+	"github.com/google/uuid"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/utils/config"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/utils/validate"
+)
+
+// Permissions on Git repositories and branches are managed in the Git Repositories security namespace
+var gitSecurityNamespaceID = uuid.MustParse("2e9eb7ed-3c0a-47d4-87c1-0ffdd275fd87")
+
+func resourceGitPermissions() *schema.Resource {
+	return genSecurityPermissionsResource(gitSecurityNamespaceID, map[string]*schema.Schema{
+		"project_id": {
+			Type:         schema.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: validate.UUID,
+		},
+		"repository_id": {
+			Type:         schema.TypeString,
+			Optional:     true,
+			ForceNew:     true,
+			ValidateFunc: validate.UUID,
+		},
+		"branch_name": {
+			Type:             schema.TypeString,
+			Optional:         true,
+			ForceNew:         true,
+			ValidateFunc:     validate.NoEmptyStrings,
+			DiffSuppressFunc: suppressBranchNamePrefixDiff,
+		},
+	}, createGitSecurityToken, parseGitPermissionsImportID)
+}
+
+// Branch names can be specified with or without the refs/heads/ prefix
+func suppressBranchNamePrefixDiff(k, old, new string, d *schema.ResourceData) bool {
+	return strings.TrimPrefix(old, "refs/heads/") == strings.TrimPrefix(new, "refs/heads/")
+}
+
+// Builds the token of a project (repoV2/<project ID>), a repository (repoV2/<project ID>/<repository ID>)
+// or a branch (repoV2/<project ID>/<repository ID>/refs/heads/<encoded branch name>)
+func createGitSecurityToken(d *schema.ResourceData, clients *config.AggregatedClient) (string, error) {
+	token := fmt.Sprintf("repoV2/%s", d.Get("project_id").(string))
+
+	repositoryID := d.Get("repository_id").(string)
+	branchName := d.Get("branch_name").(string)
+	if repositoryID == "" {
+		if branchName != "" {
+			return "", fmt.Errorf("A repository_id is required to set permissions on branch %s", branchName)
+		}
+		return token, nil
+	}
+
+	token = fmt.Sprintf("%s/%s", token, repositoryID)
+	if branchName == "" {
+		return token, nil
+	}
+	return fmt.Sprintf("%s/refs/heads/%s", token, encodeGitSecurityBranchName(branchName)), nil
+}
+
+// Encodes a branch name the way the Git Repositories namespace expects it in tokens: every segment of the
+// name is encoded as the hex string of its UTF-16 little endian representation, e.g. main becomes 6d00610069006e00
+func encodeGitSecurityBranchName(branchName string) string {
+	segments := strings.Split(strings.TrimPrefix(branchName, "refs/heads/"), "/")
+	for i, segment := range segments {
+		units := utf16.Encode([]rune(segment))
+		bytes := make([]byte, 2*len(units))
+		for j, unit := range units {
+			binary.LittleEndian.PutUint16(bytes[2*j:], unit)
+		}
+		segments[i] = hex.EncodeToString(bytes)
+	}
+	return strings.Join(segments, "/")
+}
+
+// Import ID is of the form <project ID>[/<repository ID>[/<branch name>]]/<principal descriptor>. The branch name
+// may contain slashes.
+func parseGitPermissionsImportID(d *schema.ResourceData) error {
+	id := d.Id()
+	last := strings.LastIndex(id, "/")
+	if last <= 0 || last == len(id)-1 {
+		return fmt.Errorf("Error parsing import ID %s. Expected format <project ID>[/<repository ID>[/<branch name>]]/<principal descriptor>", id)
+	}
+
+	parts := strings.SplitN(id[:last], "/", 3)
+	for i, part := range parts {
+		if _, err := uuid.Parse(part); i < 2 && err != nil {
+			return fmt.Errorf("Error parsing import ID %s. %s is not a valid UUID", id, part)
+		}
+	}
+
+	d.Set("project_id", parts[0])
+	if len(parts) > 1 {
+		d.Set("repository_id", parts[1])
+	}
+	if len(parts) > 2 {
+		d.Set("branch_name", parts[2])
+	}
+	d.Set("principal", id[last+1:])
+	return nil
+}
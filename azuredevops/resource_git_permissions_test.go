@@ -0,0 +1,108 @@
+// +build all security resource_git_permissions
+
+package azuredevops
+
+import (
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/utils/testhelper"
+	"github.com/stretchr/testify/require"
+)
+
+var testGitPermissionsProjectID = uuid.New()
+var testGitPermissionsRepositoryID = uuid.New()
+
+/**
+ * Begin unit tests
+ */
+
+// verifies that every segment of a branch name is hex encoded as UTF-16 little endian
+func TestAzureDevOpsGitPermissions_EncodeBranchName(t *testing.T) {
+	require.Equal(t, "6d00610069006e00", encodeGitSecurityBranchName("main"))
+	require.Equal(t, "6d00610069006e00", encodeGitSecurityBranchName("refs/heads/main"))
+	require.Equal(t, "66006500610074007500720065007300/e4006200", encodeGitSecurityBranchName("features/äb"))
+}
+
+// verifies that the token is built for the project, repository and branch scope
+func TestAzureDevOpsGitPermissions_CreateToken(t *testing.T) {
+	resourceData := schema.TestResourceDataRaw(t, resourceGitPermissions().Schema, nil)
+	resourceData.Set("project_id", testGitPermissionsProjectID.String())
+
+	token, err := createGitSecurityToken(resourceData, nil)
+	require.Nil(t, err)
+	require.Equal(t, "repoV2/"+testGitPermissionsProjectID.String(), token)
+
+	resourceData.Set("branch_name", "main")
+	_, err = createGitSecurityToken(resourceData, nil)
+	require.NotNil(t, err)
+
+	resourceData.Set("repository_id", testGitPermissionsRepositoryID.String())
+	token, err = createGitSecurityToken(resourceData, nil)
+	require.Nil(t, err)
+	require.Equal(t, "repoV2/"+testGitPermissionsProjectID.String()+"/"+testGitPermissionsRepositoryID.String()+"/refs/heads/6d00610069006e00", token)
+
+	resourceData.Set("branch_name", "")
+	token, err = createGitSecurityToken(resourceData, nil)
+	require.Nil(t, err)
+	require.Equal(t, "repoV2/"+testGitPermissionsProjectID.String()+"/"+testGitPermissionsRepositoryID.String(), token)
+}
+
+// verifies that the import ID is split into project, repository, branch and principal
+func TestAzureDevOpsGitPermissions_Import_ParsesID(t *testing.T) {
+	resourceData := schema.TestResourceDataRaw(t, resourceGitPermissions().Schema, nil)
+	resourceData.SetId(testGitPermissionsProjectID.String() + "/" + testGitPermissionsRepositoryID.String() + "/features/login/vssgp.readers")
+
+	err := parseGitPermissionsImportID(resourceData)
+	require.Nil(t, err)
+	require.Equal(t, testGitPermissionsProjectID.String(), resourceData.Get("project_id"))
+	require.Equal(t, testGitPermissionsRepositoryID.String(), resourceData.Get("repository_id"))
+	require.Equal(t, "features/login", resourceData.Get("branch_name"))
+	require.Equal(t, "vssgp.readers", resourceData.Get("principal"))
+
+	resourceData.SetId("project/vssgp.readers")
+	err = parseGitPermissionsImportID(resourceData)
+	require.NotNil(t, err)
+}
+
+/**
+ * Begin acceptance tests
+ */
+
+// Verifies that the permissions of a group on a repository and on a branch can be set and changed
+func TestAccAzureDevOpsGitPermissions_CreateAndUpdate(t *testing.T) {
+	projectName := testhelper.TestAccResourcePrefix + acctest.RandStringFromCharSet(10, acctest.CharSetAlphaNum)
+	gitRepoName := testhelper.TestAccResourcePrefix + acctest.RandStringFromCharSet(10, acctest.CharSetAlphaNum)
+	repositoryNode := "azuredevops_git_permissions.repository"
+	branchNode := "azuredevops_git_permissions.branch"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testhelper.TestAccPreCheck(t, nil) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccProjectCheckDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testhelper.TestAccGitPermissionsResource(projectName, gitRepoName, permissionDeny),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(repositoryNode, "permissions.GenericContribute", permissionAllow),
+					resource.TestCheckResourceAttr(repositoryNode, "permissions.ForcePush", permissionDeny),
+					resource.TestCheckResourceAttr(branchNode, "permissions.PolicyExempt", permissionDeny),
+					resource.TestCheckResourceAttr(branchNode, "inherit", "false"),
+				),
+			},
+			{
+				Config: testhelper.TestAccGitPermissionsResource(projectName, gitRepoName, permissionAllow),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(repositoryNode, "permissions.ForcePush", permissionAllow),
+				),
+			},
+		},
+	})
+}
+
+func init() {
+	InitProvider()
+}
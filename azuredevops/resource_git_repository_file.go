@@ -0,0 +1,287 @@
+package azuredevops
+
+import (
+	"fmt"
+	"net/http"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/microsoft/azure-devops-go-api/azuredevops"
+	"github.com/microsoft/azure-devops-go-api/azuredevops/git"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/utils/config"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/utils/converter"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/utils/validate"
+)
+
+// Number of times a push is retried if the branch has been updated by another client in the meantime
+var gitPushRetries = 3
+
+func resourceGitRepositoryFile() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceGitRepositoryFileCreate,
+		Read:   resourceGitRepositoryFileRead,
+		Update: resourceGitRepositoryFileUpdate,
+		Delete: resourceGitRepositoryFileDelete,
+		Importer: &schema.ResourceImporter{
+			State: importGitRepositoryFile,
+		},
+		Schema: map[string]*schema.Schema{
+			"repository_id": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validate.UUID,
+			},
+			"file": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validate.NoEmptyStrings,
+			},
+			"content": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"branch": {
+				Type:             schema.TypeString,
+				Optional:         true,
+				Computed:         true,
+				ForceNew:         true,
+				ValidateFunc:     validate.NoEmptyStrings,
+				DiffSuppressFunc: suppressBranchNamePrefixDiff,
+			},
+			"commit_message": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"author_name": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"author_email": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"commit_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func resourceGitRepositoryFileCreate(d *schema.ResourceData, m interface{}) error {
+	clients := m.(*config.AggregatedClient)
+	repoID := d.Get("repository_id").(string)
+	file := d.Get("file").(string)
+
+	branch := d.Get("branch").(string)
+	if branch == "" {
+		repo, err := clients.GitReposClient.GetRepository(clients.Ctx, git.GetRepositoryArgs{
+			RepositoryId: converter.String(repoID),
+		})
+		if err != nil {
+			return fmt.Errorf("Error reading repository %s: %+v", repoID, err)
+		}
+		if repo.DefaultBranch == nil {
+			return fmt.Errorf("Repository %s does not have a default branch to add file %s to", repoID, file)
+		}
+		branch = *repo.DefaultBranch
+	}
+	branch = withBranchPrefix(branch)
+
+	err := pushGitRepositoryFileChange(d, clients, repoID, branch, git.VersionControlChangeTypeValues.Add, "Add")
+	if err != nil {
+		return fmt.Errorf("Error adding file %s to branch %s: %+v", file, branch, err)
+	}
+
+	d.SetId(fmt.Sprintf("%s:%s:%s", repoID, branch, file))
+	d.Set("branch", branch)
+	return resourceGitRepositoryFileRead(d, m)
+}
+
+func resourceGitRepositoryFileRead(d *schema.ResourceData, m interface{}) error {
+	clients := m.(*config.AggregatedClient)
+	repoID := d.Get("repository_id").(string)
+	branch := withBranchPrefix(d.Get("branch").(string))
+	file := d.Get("file").(string)
+
+	item, err := clients.GitReposClient.GetItem(clients.Ctx, git.GetItemArgs{
+		RepositoryId:   converter.String(repoID),
+		Path:           converter.String(file),
+		IncludeContent: converter.Bool(true),
+		VersionDescriptor: &git.GitVersionDescriptor{
+			Version:     converter.String(strings.TrimPrefix(branch, "refs/heads/")),
+			VersionType: &git.GitVersionTypeValues.Branch,
+		},
+	})
+	if err != nil {
+		// the file has been removed outside of Terraform
+		if isGitItemNotFoundError(err) {
+			d.SetId("")
+			return nil
+		}
+		return fmt.Errorf("Error reading file %s on branch %s: %+v", file, branch, err)
+	}
+
+	d.Set("content", converter.ToString(item.Content, ""))
+	d.Set("commit_id", converter.ToString(item.CommitId, ""))
+	return nil
+}
+
+func resourceGitRepositoryFileUpdate(d *schema.ResourceData, m interface{}) error {
+	clients := m.(*config.AggregatedClient)
+	repoID := d.Get("repository_id").(string)
+	branch := withBranchPrefix(d.Get("branch").(string))
+	file := d.Get("file").(string)
+
+	if d.HasChange("content") {
+		err := pushGitRepositoryFileChange(d, clients, repoID, branch, git.VersionControlChangeTypeValues.Edit, "Update")
+		if err != nil {
+			return fmt.Errorf("Error updating file %s on branch %s: %+v", file, branch, err)
+		}
+	}
+	return resourceGitRepositoryFileRead(d, m)
+}
+
+func resourceGitRepositoryFileDelete(d *schema.ResourceData, m interface{}) error {
+	clients := m.(*config.AggregatedClient)
+	repoID := d.Get("repository_id").(string)
+	branch := withBranchPrefix(d.Get("branch").(string))
+	file := d.Get("file").(string)
+
+	err := pushGitRepositoryFileChange(d, clients, repoID, branch, git.VersionControlChangeTypeValues.Delete, "Delete")
+	if err != nil {
+		return fmt.Errorf("Error deleting file %s from branch %s: %+v", file, branch, err)
+	}
+
+	d.SetId("")
+	return nil
+}
+
+// Import ID is of the form <repository ID>:<branch>:<file path>, e.g.
+// 782a8123-1019-xxxx-xxxx-xxxxxxxx:refs/heads/master:/azure-pipelines.yml
+func importGitRepositoryFile(d *schema.ResourceData, m interface{}) ([]*schema.ResourceData, error) {
+	parts := strings.SplitN(d.Id(), ":", 3)
+	if len(parts) != 3 || parts[0] == "" || parts[1] == "" || parts[2] == "" {
+		return nil, fmt.Errorf("Error parsing import ID %s. Expected format <repository ID>:<branch>:<file path>", d.Id())
+	}
+
+	d.Set("repository_id", parts[0])
+	d.Set("branch", withBranchPrefix(parts[1]))
+	d.Set("file", parts[2])
+	d.SetId(fmt.Sprintf("%s:%s:%s", parts[0], withBranchPrefix(parts[1]), parts[2]))
+	return []*schema.ResourceData{d}, nil
+}
+
+// Pushes a commit with a single change of the file to the branch. If the push fails because the branch has been
+// updated by another client after its head was read, the push is retried on top of the new head of the branch.
+func pushGitRepositoryFileChange(d *schema.ResourceData, clients *config.AggregatedClient, repoID string, branch string, changeType git.VersionControlChangeType, action string) error {
+	file := d.Get("file").(string)
+	change := git.Change{
+		ChangeType: &changeType,
+		Item: git.GitItem{
+			Path: converter.String(file),
+		},
+	}
+	if changeType != git.VersionControlChangeTypeValues.Delete {
+		// empty files are valid, so the content is not converted with converter.String
+		content := d.Get("content").(string)
+		change.NewContent = &git.ItemContent{
+			ContentType: &git.ItemContentTypeValues.RawText,
+			Content:     &content,
+		}
+	}
+
+	message := d.Get("commit_message").(string)
+	if message == "" {
+		message = fmt.Sprintf("%s %s", action, file)
+	}
+	commit := git.GitCommitRef{
+		Comment: converter.String(message),
+		Changes: &[]interface{}{change},
+	}
+	if authorName := d.Get("author_name").(string); authorName != "" {
+		commit.Author = &git.GitUserDate{
+			Name:  converter.String(authorName),
+			Email: converter.String(d.Get("author_email").(string)),
+		}
+	}
+
+	objectID, err := getGitBranchObjectID(clients, repoID, branch)
+	if err != nil {
+		return err
+	}
+	for attempt := 0; ; attempt++ {
+		_, err = clients.GitReposClient.CreatePush(clients.Ctx, git.CreatePushArgs{
+			RepositoryId: converter.String(repoID),
+			Push: &git.GitPush{
+				RefUpdates: &[]git.GitRefUpdate{{
+					Name:        converter.String(branch),
+					OldObjectId: converter.String(objectID),
+				}},
+				Commits: &[]git.GitCommitRef{commit},
+			},
+		})
+		if err == nil || attempt >= gitPushRetries {
+			return err
+		}
+
+		latestObjectID, readErr := getGitBranchObjectID(clients, repoID, branch)
+		if readErr != nil || latestObjectID == objectID {
+			return err
+		}
+		objectID = latestObjectID
+	}
+}
+
+// Reads the ID of the commit the branch points to
+func getGitBranchObjectID(clients *config.AggregatedClient, repoID string, branch string) (string, error) {
+	ref, err := getGitRef(clients, repoID, branch)
+	if err != nil {
+		return "", err
+	}
+	if ref == nil {
+		return "", fmt.Errorf("Branch %s does not exist in repository %s", branch, repoID)
+	}
+	return converter.ToString(ref.ObjectId, ""), nil
+}
+
+// Looks up a ref of the repository by its full name. Returns nil if the ref does not exist.
+func getGitRef(clients *config.AggregatedClient, repoID string, name string) (*git.GitRef, error) {
+	refs, err := clients.GitReposClient.GetRefs(clients.Ctx, git.GetRefsArgs{
+		RepositoryId: converter.String(repoID),
+		Filter:       converter.String(strings.TrimPrefix(name, "refs/")),
+		PeelTags:     converter.Bool(true),
+	})
+	if err != nil {
+		return nil, fmt.Errorf("Error reading ref %s of repository %s: %+v", name, repoID, err)
+	}
+	if refs == nil {
+		return nil, fmt.Errorf("No refs returned for ref %s of repository %s", name, repoID)
+	}
+
+	for _, ref := range refs.Value {
+		if converter.ToString(ref.Name, "") == name {
+			return &ref, nil
+		}
+	}
+	return nil, nil
+}
+
+// The status code is not set on all errors returned by the service, so the type of the error is checked as well
+func isGitItemNotFoundError(err error) bool {
+	var wrappedError *azuredevops.WrappedError
+	switch e := err.(type) {
+	case azuredevops.WrappedError:
+		wrappedError = &e
+	case *azuredevops.WrappedError:
+		wrappedError = e
+	default:
+		return false
+	}
+	if wrappedError.StatusCode != nil && *wrappedError.StatusCode == http.StatusNotFound {
+		return true
+	}
+	return converter.ToString(wrappedError.TypeKey, "") == "GitItemNotFoundException"
+}
@@ -0,0 +1,218 @@
+// +build all core resource_git_repository_file
+
+package azuredevops
+
+// The tests in this file use the mock clients in mock_client.go to mock out
+// the Azure DevOps client operations.
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/google/uuid"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/terraform"
+	"github.com/microsoft/azure-devops-go-api/azuredevops"
+	"github.com/microsoft/azure-devops-go-api/azuredevops/git"
+	"github.com/microsoft/terraform-provider-azuredevops/azdosdkmocks"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/utils/config"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/utils/converter"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/utils/testhelper"
+	"github.com/stretchr/testify/require"
+)
+
+var testGitFileRepoID = uuid.New().String()
+
+/**
+ * Begin unit tests
+ */
+
+// verifies that a push is retried on top of the new head of the branch if the branch was updated concurrently
+func TestGitRepositoryFile_Create_RetriesWithLatestObjectID(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	reposClient := azdosdkmocks.NewMockGitClient(ctrl)
+	clients := &config.AggregatedClient{GitReposClient: reposClient, Ctx: context.Background()}
+
+	resourceData := schema.TestResourceDataRaw(t, resourceGitRepositoryFile().Schema, nil)
+	resourceData.Set("repository_id", testGitFileRepoID)
+	resourceData.Set("file", "/CODEOWNERS")
+	resourceData.Set("content", "* @team")
+	resourceData.Set("branch", "master")
+	resourceData.Set("author_name", "Terraform")
+	resourceData.Set("author_email", "terraform@example.com")
+
+	refsArgs := git.GetRefsArgs{
+		RepositoryId: converter.String(testGitFileRepoID),
+		Filter:       converter.String("heads/master"),
+		PeelTags:     converter.Bool(true),
+	}
+	gomock.InOrder(
+		reposClient.EXPECT().GetRefs(clients.Ctx, refsArgs).
+			Return(&git.GetRefsResponseValue{Value: []git.GitRef{{Name: converter.String("refs/heads/master"), ObjectId: converter.String("1")}}}, nil),
+		reposClient.EXPECT().CreatePush(clients.Ctx, gomock.Any()).
+			DoAndReturn(func(ctx context.Context, args git.CreatePushArgs) (*git.GitPush, error) {
+				require.Equal(t, "1", *(*args.Push.RefUpdates)[0].OldObjectId)
+				return nil, errors.New("TF401028: The reference has already been updated by another client")
+			}),
+		reposClient.EXPECT().GetRefs(clients.Ctx, refsArgs).
+			Return(&git.GetRefsResponseValue{Value: []git.GitRef{{Name: converter.String("refs/heads/master"), ObjectId: converter.String("2")}}}, nil),
+		reposClient.EXPECT().CreatePush(clients.Ctx, gomock.Any()).
+			DoAndReturn(func(ctx context.Context, args git.CreatePushArgs) (*git.GitPush, error) {
+				require.Equal(t, "refs/heads/master", *(*args.Push.RefUpdates)[0].Name)
+				require.Equal(t, "2", *(*args.Push.RefUpdates)[0].OldObjectId)
+				commit := (*args.Push.Commits)[0]
+				require.Equal(t, "Add /CODEOWNERS", *commit.Comment)
+				require.Equal(t, "Terraform", *commit.Author.Name)
+				require.Equal(t, "terraform@example.com", *commit.Author.Email)
+				return &git.GitPush{}, nil
+			}),
+		reposClient.EXPECT().GetItem(clients.Ctx, gomock.Any()).
+			Return(&git.GitItem{Content: converter.String("* @team"), CommitId: converter.String("3")}, nil),
+	)
+
+	err := resourceGitRepositoryFileCreate(resourceData, clients)
+	require.Nil(t, err)
+	require.Equal(t, testGitFileRepoID+":refs/heads/master:/CODEOWNERS", resourceData.Id())
+	require.Equal(t, "3", resourceData.Get("commit_id"))
+}
+
+// verifies that a failed push is not retried if the branch was not updated concurrently
+func TestGitRepositoryFile_Create_DoesNotSwallowErrorFromFailedPush(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	reposClient := azdosdkmocks.NewMockGitClient(ctrl)
+	clients := &config.AggregatedClient{GitReposClient: reposClient, Ctx: context.Background()}
+
+	resourceData := schema.TestResourceDataRaw(t, resourceGitRepositoryFile().Schema, nil)
+	resourceData.Set("repository_id", testGitFileRepoID)
+	resourceData.Set("file", "/CODEOWNERS")
+	resourceData.Set("content", "* @team")
+	resourceData.Set("branch", "refs/heads/master")
+
+	reposClient.
+		EXPECT().
+		GetRefs(clients.Ctx, gomock.Any()).
+		Return(&git.GetRefsResponseValue{Value: []git.GitRef{{Name: converter.String("refs/heads/master"), ObjectId: converter.String("1")}}}, nil).
+		Times(2)
+	reposClient.
+		EXPECT().
+		CreatePush(clients.Ctx, gomock.Any()).
+		Return(nil, errors.New("CreatePush() Failed")).
+		Times(1)
+
+	err := resourceGitRepositoryFileCreate(resourceData, clients)
+	require.Contains(t, err.Error(), "CreatePush() Failed")
+}
+
+// verifies that a file that was removed outside of Terraform is removed from the state
+func TestGitRepositoryFile_Read_RemovesDeletedFile(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	reposClient := azdosdkmocks.NewMockGitClient(ctrl)
+	clients := &config.AggregatedClient{GitReposClient: reposClient, Ctx: context.Background()}
+
+	resourceData := schema.TestResourceDataRaw(t, resourceGitRepositoryFile().Schema, nil)
+	resourceData.SetId(testGitFileRepoID + ":refs/heads/master:/CODEOWNERS")
+	resourceData.Set("repository_id", testGitFileRepoID)
+	resourceData.Set("file", "/CODEOWNERS")
+	resourceData.Set("branch", "refs/heads/master")
+
+	reposClient.
+		EXPECT().
+		GetItem(clients.Ctx, git.GetItemArgs{
+			RepositoryId:   converter.String(testGitFileRepoID),
+			Path:           converter.String("/CODEOWNERS"),
+			IncludeContent: converter.Bool(true),
+			VersionDescriptor: &git.GitVersionDescriptor{
+				Version:     converter.String("master"),
+				VersionType: &git.GitVersionTypeValues.Branch,
+			},
+		}).
+		Return(nil, azuredevops.WrappedError{TypeKey: converter.String("GitItemNotFoundException")}).
+		Times(1)
+
+	err := resourceGitRepositoryFileRead(resourceData, clients)
+	require.Nil(t, err)
+	require.Equal(t, "", resourceData.Id())
+}
+
+// verifies that the import ID is split into repository, branch and file
+func TestGitRepositoryFile_Import_ParsesID(t *testing.T) {
+	resourceData := schema.TestResourceDataRaw(t, resourceGitRepositoryFile().Schema, nil)
+	resourceData.SetId(testGitFileRepoID + ":master:/pipelines/build.yml")
+
+	_, err := importGitRepositoryFile(resourceData, nil)
+	require.Nil(t, err)
+	require.Equal(t, testGitFileRepoID, resourceData.Get("repository_id"))
+	require.Equal(t, "refs/heads/master", resourceData.Get("branch"))
+	require.Equal(t, "/pipelines/build.yml", resourceData.Get("file"))
+	require.Equal(t, testGitFileRepoID+":refs/heads/master:/pipelines/build.yml", resourceData.Id())
+
+	resourceData.SetId(testGitFileRepoID + ":master")
+	_, err = importGitRepositoryFile(resourceData, nil)
+	require.NotNil(t, err)
+}
+
+/**
+ * Begin acceptance tests
+ */
+
+// Verifies that a file can be added, changed, imported and removed
+func TestAccGitRepositoryFile_CreateAndUpdate(t *testing.T) {
+	projectName := testhelper.TestAccResourcePrefix + acctest.RandStringFromCharSet(10, acctest.CharSetAlphaNum)
+	gitRepoName := testhelper.TestAccResourcePrefix + acctest.RandStringFromCharSet(10, acctest.CharSetAlphaNum)
+	tfFileNode := "azuredevops_git_repository_file.file"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testhelper.TestAccPreCheck(t, nil) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccAzureGitRepoCheckDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testhelper.TestAccGitRepositoryFileResource(projectName, gitRepoName, "first version"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(tfFileNode, "content", "first version"),
+					resource.TestCheckResourceAttr(tfFileNode, "branch", "refs/heads/master"),
+					resource.TestCheckResourceAttrSet(tfFileNode, "commit_id"),
+				),
+			},
+			{
+				Config: testhelper.TestAccGitRepositoryFileResource(projectName, gitRepoName, "second version"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(tfFileNode, "content", "second version"),
+				),
+			},
+			{
+				ResourceName:      tfFileNode,
+				ImportStateIdFunc: testAccGitRepositoryFileImportStateIDFunc(tfFileNode),
+				ImportState:       true,
+				ImportStateVerify: true,
+				// the commit settings are not part of the file
+				ImportStateVerifyIgnore: []string{"commit_message", "author_name", "author_email"},
+			},
+		},
+	})
+}
+
+func testAccGitRepositoryFileImportStateIDFunc(resourceName string) resource.ImportStateIdFunc {
+	return func(s *terraform.State) (string, error) {
+		res, ok := s.RootModule().Resources[resourceName]
+		if !ok {
+			return "", fmt.Errorf("Did not find %s in the TF state", resourceName)
+		}
+		return res.Primary.ID, nil
+	}
+}
+
+func init() {
+	InitProvider()
+}
@@ -0,0 +1,189 @@
+package azuredevops
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/microsoft/azure-devops-go-api/azuredevops/git"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/utils/config"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/utils/converter"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/utils/validate"
+)
+
+func resourceGitTag() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceGitTagCreate,
+		Read:   resourceGitTagRead,
+		Delete: resourceGitTagDelete,
+		Importer: &schema.ResourceImporter{
+			State: importGitTag,
+		},
+		Schema: map[string]*schema.Schema{
+			"project_id": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validate.UUID,
+			},
+			"repository_id": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validate.UUID,
+			},
+			"name": {
+				Type:             schema.TypeString,
+				Required:         true,
+				ForceNew:         true,
+				ValidateFunc:     validate.NoEmptyStrings,
+				DiffSuppressFunc: suppressTagNamePrefixDiff,
+			},
+			"message": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validate.NoEmptyStrings,
+			},
+			"ref_branch": {
+				Type:             schema.TypeString,
+				Optional:         true,
+				ForceNew:         true,
+				ValidateFunc:     validate.NoEmptyStrings,
+				DiffSuppressFunc: suppressGitRefSourceDiff,
+				ConflictsWith:    []string{"ref_commit_id"},
+			},
+			"ref_commit_id": {
+				Type:             schema.TypeString,
+				Optional:         true,
+				ForceNew:         true,
+				ValidateFunc:     validate.NoEmptyStrings,
+				DiffSuppressFunc: suppressGitRefSourceDiff,
+				ConflictsWith:    []string{"ref_branch"},
+			},
+			"object_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"commit_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+// Tag names can be specified with or without the refs/tags/ prefix
+func suppressTagNamePrefixDiff(k, old, new string, d *schema.ResourceData) bool {
+	return strings.TrimPrefix(old, "refs/tags/") == strings.TrimPrefix(new, "refs/tags/")
+}
+
+func resourceGitTagCreate(d *schema.ResourceData, m interface{}) error {
+	clients := m.(*config.AggregatedClient)
+	repoID := d.Get("repository_id").(string)
+	name := withTagPrefix(d.Get("name").(string))
+
+	var commitID string
+	if id, ok := d.GetOk("ref_commit_id"); ok {
+		commitID = id.(string)
+	} else if branch, ok := d.GetOk("ref_branch"); ok {
+		var err error
+		commitID, err = resolveGitRefCommitID(clients, repoID, withBranchPrefix(branch.(string)))
+		if err != nil {
+			return err
+		}
+	} else {
+		return fmt.Errorf("One of ref_branch or ref_commit_id is required to create a tag")
+	}
+
+	_, err := clients.GitReposClient.CreateAnnotatedTag(clients.Ctx, git.CreateAnnotatedTagArgs{
+		Project:      converter.String(d.Get("project_id").(string)),
+		RepositoryId: converter.String(repoID),
+		TagObject: &git.GitAnnotatedTag{
+			Name:    converter.String(strings.TrimPrefix(name, "refs/tags/")),
+			Message: converter.String(d.Get("message").(string)),
+			TaggedObject: &git.GitObject{
+				ObjectId: converter.String(commitID),
+			},
+		},
+	})
+	if err != nil {
+		return fmt.Errorf("Error creating tag %s: %+v", name, err)
+	}
+
+	d.SetId(fmt.Sprintf("%s:%s", repoID, name))
+	d.Set("name", name)
+	return resourceGitTagRead(d, m)
+}
+
+func resourceGitTagRead(d *schema.ResourceData, m interface{}) error {
+	clients := m.(*config.AggregatedClient)
+	repoID := d.Get("repository_id").(string)
+	name := withTagPrefix(d.Get("name").(string))
+
+	ref, err := getGitRef(clients, repoID, name)
+	if err != nil {
+		return err
+	}
+	// the tag has been deleted outside of Terraform
+	if ref == nil {
+		d.SetId("")
+		return nil
+	}
+
+	tag, err := clients.GitReposClient.GetAnnotatedTag(clients.Ctx, git.GetAnnotatedTagArgs{
+		Project:      converter.String(d.Get("project_id").(string)),
+		RepositoryId: converter.String(repoID),
+		ObjectId:     ref.ObjectId,
+	})
+	if err != nil {
+		return fmt.Errorf("Error reading tag %s: %+v", name, err)
+	}
+
+	d.Set("message", converter.ToString(tag.Message, ""))
+	d.Set("object_id", converter.ToString(ref.ObjectId, ""))
+	d.Set("commit_id", converter.ToString(ref.PeeledObjectId, ""))
+	return nil
+}
+
+func resourceGitTagDelete(d *schema.ResourceData, m interface{}) error {
+	clients := m.(*config.AggregatedClient)
+	repoID := d.Get("repository_id").(string)
+	name := withTagPrefix(d.Get("name").(string))
+
+	ref, err := getGitRef(clients, repoID, name)
+	if err != nil {
+		return err
+	}
+	if ref != nil {
+		if err := updateGitRef(clients, repoID, name, converter.ToString(ref.ObjectId, ""), gitEmptyObjectID); err != nil {
+			return fmt.Errorf("Error deleting tag %s: %+v", name, err)
+		}
+	}
+
+	d.SetId("")
+	return nil
+}
+
+// Import ID is of the form <repository ID>:<tag>, e.g. 782a8123-1019-xxxx-xxxx-xxxxxxxx:refs/tags/v1.0. The project
+// is read from the repository.
+func importGitTag(d *schema.ResourceData, m interface{}) ([]*schema.ResourceData, error) {
+	repoID, name, err := parseGitRefImportID(d.Id(), "refs/tags/")
+	if err != nil {
+		return nil, err
+	}
+
+	clients := m.(*config.AggregatedClient)
+	repo, err := clients.GitReposClient.GetRepository(clients.Ctx, git.GetRepositoryArgs{
+		RepositoryId: converter.String(repoID),
+	})
+	if err != nil {
+		return nil, fmt.Errorf("Error reading repository %s: %+v", repoID, err)
+	}
+
+	d.Set("project_id", repo.Project.Id.String())
+	d.Set("repository_id", repoID)
+	d.Set("name", name)
+	d.SetId(fmt.Sprintf("%s:%s", repoID, name))
+	return []*schema.ResourceData{d}, nil
+}
@@ -0,0 +1,128 @@
+// +build all core resource_git_tag
+
+package azuredevops
+
+// The tests in this file use the mock clients in mock_client.go to mock out
+// the Azure DevOps client operations.
+
+import (
+	"context"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/google/uuid"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/terraform"
+	"github.com/microsoft/azure-devops-go-api/azuredevops/core"
+	"github.com/microsoft/azure-devops-go-api/azuredevops/git"
+	"github.com/microsoft/terraform-provider-azuredevops/azdosdkmocks"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/utils/config"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/utils/converter"
+	"github.com/stretchr/testify/require"
+)
+
+var testGitTagProjectID = uuid.New()
+var testGitTagRepoID = uuid.New().String()
+
+/**
+ * Begin unit tests
+ */
+
+// verifies that an annotated tag is created on the head of the branch and that its message is read back
+func TestGitTag_Create_CreatesAnnotatedTag(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	reposClient := azdosdkmocks.NewMockGitClient(ctrl)
+	clients := &config.AggregatedClient{GitReposClient: reposClient, Ctx: context.Background()}
+
+	resourceData := schema.TestResourceDataRaw(t, resourceGitTag().Schema, nil)
+	resourceData.Set("project_id", testGitTagProjectID.String())
+	resourceData.Set("repository_id", testGitTagRepoID)
+	resourceData.Set("name", "v1.0")
+	resourceData.Set("message", "Release 1.0")
+	resourceData.Set("ref_branch", "master")
+
+	gomock.InOrder(
+		reposClient.EXPECT().GetRefs(clients.Ctx, gomock.Any()).
+			Return(&git.GetRefsResponseValue{Value: []git.GitRef{{Name: converter.String("refs/heads/master"), ObjectId: converter.String("commit")}}}, nil),
+		reposClient.EXPECT().CreateAnnotatedTag(clients.Ctx, git.CreateAnnotatedTagArgs{
+			Project:      converter.String(testGitTagProjectID.String()),
+			RepositoryId: converter.String(testGitTagRepoID),
+			TagObject: &git.GitAnnotatedTag{
+				Name:         converter.String("v1.0"),
+				Message:      converter.String("Release 1.0"),
+				TaggedObject: &git.GitObject{ObjectId: converter.String("commit")},
+			},
+		}).Return(&git.GitAnnotatedTag{}, nil),
+		reposClient.EXPECT().GetRefs(clients.Ctx, gomock.Any()).
+			Return(&git.GetRefsResponseValue{Value: []git.GitRef{{
+				Name:           converter.String("refs/tags/v1.0"),
+				ObjectId:       converter.String("tag"),
+				PeeledObjectId: converter.String("commit"),
+			}}}, nil),
+		reposClient.EXPECT().GetAnnotatedTag(clients.Ctx, git.GetAnnotatedTagArgs{
+			Project:      converter.String(testGitTagProjectID.String()),
+			RepositoryId: converter.String(testGitTagRepoID),
+			ObjectId:     converter.String("tag"),
+		}).Return(&git.GitAnnotatedTag{Message: converter.String("Release 1.0")}, nil),
+	)
+
+	err := resourceGitTagCreate(resourceData, clients)
+	require.Nil(t, err)
+	require.Equal(t, testGitTagRepoID+":refs/tags/v1.0", resourceData.Id())
+	require.Equal(t, "tag", resourceData.Get("object_id"))
+	require.Equal(t, "commit", resourceData.Get("commit_id"))
+}
+
+// verifies that the project of an imported tag is read from the repository
+func TestGitTag_Import_ReadsProject(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	reposClient := azdosdkmocks.NewMockGitClient(ctrl)
+	clients := &config.AggregatedClient{GitReposClient: reposClient, Ctx: context.Background()}
+
+	reposClient.
+		EXPECT().
+		GetRepository(clients.Ctx, git.GetRepositoryArgs{RepositoryId: converter.String(testGitTagRepoID)}).
+		Return(&git.GitRepository{Project: &core.TeamProjectReference{Id: &testGitTagProjectID}}, nil).
+		Times(1)
+
+	resourceData := schema.TestResourceDataRaw(t, resourceGitTag().Schema, nil)
+	resourceData.SetId(testGitTagRepoID + ":v1.0")
+
+	_, err := importGitTag(resourceData, clients)
+	require.Nil(t, err)
+	require.Equal(t, testGitTagProjectID.String(), resourceData.Get("project_id"))
+	require.Equal(t, "refs/tags/v1.0", resourceData.Get("name"))
+	require.Equal(t, testGitTagRepoID+":refs/tags/v1.0", resourceData.Id())
+}
+
+// verifies that the source of an existing tag, which is not set after an import, does not replace the tag
+func TestGitTag_Diff_IgnoresSourceOfExistingTag(t *testing.T) {
+	state := &terraform.InstanceState{
+		ID: testGitTagRepoID + ":refs/tags/v1.0",
+		Attributes: map[string]string{
+			"project_id":    testGitTagProjectID.String(),
+			"repository_id": testGitTagRepoID,
+			"name":          "refs/tags/v1.0",
+			"message":       "Release 1.0",
+		},
+	}
+	config := terraform.NewResourceConfigRaw(map[string]interface{}{
+		"project_id":    testGitTagProjectID.String(),
+		"repository_id": testGitTagRepoID,
+		"name":          "v1.0",
+		"message":       "Release 1.0",
+		"ref_commit_id": "2f3b8d3bd5b0d45d2e3c0f0aa2a1e8ba6ec8e4a1",
+	})
+
+	diff, err := resourceGitTag().Diff(state, config, nil)
+	require.Nil(t, err)
+	require.True(t, diff == nil || diff.Empty())
+}
+
+func init() {
+	InitProvider()
+}
@@ -0,0 +1,10 @@
+package azuredevops
+
+import (
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/microsoft/azure-devops-go-api/azuredevops/workitemtracking"
+)
+
+func resourceIterationPermissions() *schema.Resource {
+	return genClassificationNodePermissionsResource(workitemtracking.TreeStructureGroupValues.Iterations, iterationSecurityNamespaceID)
+}
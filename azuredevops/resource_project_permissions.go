@@ -0,0 +1,48 @@
+package azuredevops
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/google/uuid"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/utils/config"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/utils/validate"
+)
+
+// Permissions on a project are managed in the Project security namespace
+var projectSecurityNamespaceID = uuid.MustParse("52d39943-cb85-4d7f-8fa8-c6baac873819")
+
+func resourceProjectPermissions() *schema.Resource {
+	return genSecurityPermissionsResource(projectSecurityNamespaceID, map[string]*schema.Schema{
+		"project_id": {
+			Type:         schema.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: validate.UUID,
+		},
+	}, createProjectSecurityToken, parseProjectPermissionsImportID)
+}
+
+func createProjectSecurityToken(d *schema.ResourceData, clients *config.AggregatedClient) (string, error) {
+	return getProjectSecurityToken(d.Get("project_id").(string)), nil
+}
+
+func getProjectSecurityToken(projectID string) string {
+	return fmt.Sprintf("$PROJECT:vstfs:///Classification/TeamProject/%s", projectID)
+}
+
+// Import ID is of the form <project ID>/<principal descriptor>
+func parseProjectPermissionsImportID(d *schema.ResourceData) error {
+	parts := strings.SplitN(d.Id(), "/", 2)
+	if len(parts) != 2 || parts[0] == "" || parts[1] == "" {
+		return fmt.Errorf("Error parsing import ID %s. Expected format <project ID>/<principal descriptor>", d.Id())
+	}
+	if _, err := uuid.Parse(parts[0]); err != nil {
+		return fmt.Errorf("Error parsing import ID %s. Project ID %s is not a valid UUID", d.Id(), parts[0])
+	}
+
+	d.Set("project_id", parts[0])
+	d.Set("principal", parts[1])
+	return nil
+}
@@ -0,0 +1,157 @@
+// +build all security resource_project_permissions
+
+package azuredevops
+
+// The tests in this file use the mock clients in mock_client.go to mock out
+// the Azure DevOps client operations.
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/google/uuid"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/terraform"
+	"github.com/microsoft/azure-devops-go-api/azuredevops/identity"
+	"github.com/microsoft/azure-devops-go-api/azuredevops/security"
+	"github.com/microsoft/terraform-provider-azuredevops/azdosdkmocks"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/utils/config"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/utils/converter"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/utils/testhelper"
+	"github.com/stretchr/testify/require"
+)
+
+var testProjectPermissionsProjectID = uuid.New()
+
+/**
+ * Begin unit tests
+ */
+
+// verifies that the permissions are set on the token of the project in the Project namespace
+func TestAzureDevOpsProjectPermissions_Create_UsesProjectToken(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	securityClient := azdosdkmocks.NewMockSecurityClient(ctrl)
+	identityClient := azdosdkmocks.NewMockIdentityClient(ctrl)
+	clients := &config.AggregatedClient{
+		SecurityClient: securityClient,
+		IdentityClient: identityClient,
+		Ctx:            context.Background(),
+	}
+
+	resourceData := schema.TestResourceDataRaw(t, resourceProjectPermissions().Schema, nil)
+	resourceData.Set("project_id", testProjectPermissionsProjectID.String())
+	resourceData.Set("principal", "vssgp.readers")
+	resourceData.Set("permissions", map[string]interface{}{"RENAME": permissionDeny})
+
+	expectedToken := "$PROJECT:vstfs:///Classification/TeamProject/" + testProjectPermissionsProjectID.String()
+	securityClient.
+		EXPECT().
+		QuerySecurityNamespaces(clients.Ctx, security.QuerySecurityNamespacesArgs{SecurityNamespaceId: &projectSecurityNamespaceID}).
+		Return(&[]security.SecurityNamespaceDescription{{
+			Actions: &[]security.ActionDefinition{{Name: converter.String("RENAME"), Bit: converter.Int(8192)}},
+		}}, nil).
+		Times(1)
+	identityClient.
+		EXPECT().
+		ReadIdentities(clients.Ctx, identity.ReadIdentitiesArgs{SubjectDescriptors: converter.String("vssgp.readers")}).
+		Return(&[]identity.Identity{{Descriptor: converter.String("Microsoft.TeamFoundation.Identity;S-1-readers")}}, nil).
+		Times(1)
+	securityClient.
+		EXPECT().
+		QueryAccessControlLists(clients.Ctx, security.QueryAccessControlListsArgs{
+			SecurityNamespaceId: &projectSecurityNamespaceID,
+			Token:               converter.String(expectedToken),
+		}).
+		Return(&[]security.AccessControlList{}, nil).
+		Times(1)
+	securityClient.
+		EXPECT().
+		SetAccessControlEntries(clients.Ctx, security.SetAccessControlEntriesArgs{
+			SecurityNamespaceId: &projectSecurityNamespaceID,
+			Container: map[string]interface{}{
+				"token": expectedToken,
+				"merge": false,
+				"accessControlEntries": []security.AccessControlEntry{{
+					Descriptor: converter.String("Microsoft.TeamFoundation.Identity;S-1-readers"),
+					Allow:      converter.Int(0),
+					Deny:       converter.Int(8192),
+				}},
+			},
+		}).
+		Return(nil, errors.New("SetAccessControlEntries() Failed")).
+		Times(1)
+
+	err := resourceProjectPermissions().Create(resourceData, clients)
+	require.Contains(t, err.Error(), "SetAccessControlEntries() Failed")
+}
+
+// verifies that the import ID is split into project and principal
+func TestAzureDevOpsProjectPermissions_Import_ParsesID(t *testing.T) {
+	resourceData := schema.TestResourceDataRaw(t, resourceProjectPermissions().Schema, nil)
+	resourceData.SetId(testProjectPermissionsProjectID.String() + "/vssgp.readers")
+
+	err := parseProjectPermissionsImportID(resourceData)
+	require.Nil(t, err)
+	require.Equal(t, testProjectPermissionsProjectID.String(), resourceData.Get("project_id"))
+	require.Equal(t, "vssgp.readers", resourceData.Get("principal"))
+
+	resourceData.SetId("project/vssgp.readers")
+	err = parseProjectPermissionsImportID(resourceData)
+	require.NotNil(t, err)
+}
+
+/**
+ * Begin acceptance tests
+ */
+
+// Verifies that the permissions of a group on a project can be set and changed
+func TestAccAzureDevOpsProjectPermissions_CreateAndUpdate(t *testing.T) {
+	projectName := testhelper.TestAccResourcePrefix + acctest.RandStringFromCharSet(10, acctest.CharSetAlphaNum)
+	tfNode := "azuredevops_project_permissions.permissions"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testhelper.TestAccPreCheck(t, nil) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccProjectCheckDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testhelper.TestAccProjectPermissionsResource(projectName, permissionDeny),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(tfNode, "permissions.GENERIC_READ", permissionAllow),
+					resource.TestCheckResourceAttr(tfNode, "permissions.RENAME", permissionDeny),
+				),
+			},
+			{
+				Config: testhelper.TestAccProjectPermissionsResource(projectName, permissionAllow),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(tfNode, "permissions.RENAME", permissionAllow),
+				),
+			},
+			{
+				ResourceName:            tfNode,
+				ImportStateIdFunc:       testAccProjectPermissionsImportStateIDFunc(tfNode),
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"permissions", "replace"},
+			},
+		},
+	})
+}
+
+func testAccProjectPermissionsImportStateIDFunc(resourceName string) resource.ImportStateIdFunc {
+	return func(s *terraform.State) (string, error) {
+		res := s.RootModule().Resources[resourceName]
+		return fmt.Sprintf("%s/%s", res.Primary.Attributes["project_id"], res.Primary.Attributes["principal"]), nil
+	}
+}
+
+func init() {
+	InitProvider()
+}
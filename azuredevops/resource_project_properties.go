@@ -0,0 +1,241 @@
+package azuredevops
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/google/uuid"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/microsoft/azure-devops-go-api/azuredevops/core"
+	"github.com/microsoft/azure-devops-go-api/azuredevops/webapi"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/utils/config"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/utils/converter"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/utils/validate"
+)
+
+// Properties with this prefix are maintained by Azure DevOps and are never managed by this resource
+const systemProjectPropertyPrefix = "System."
+
+func resourceProjectProperties() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceProjectPropertiesCreate,
+		Read:   resourceProjectPropertiesRead,
+		Update: resourceProjectPropertiesUpdate,
+		Delete: resourceProjectPropertiesDelete,
+		Importer: &schema.ResourceImporter{
+			State: importProjectProperties,
+		},
+		Schema: map[string]*schema.Schema{
+			"project_id": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validate.UUID,
+			},
+			"properties": {
+				Type:         schema.TypeMap,
+				Required:     true,
+				ValidateFunc: validateProjectPropertyNames,
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
+		},
+	}
+}
+
+func resourceProjectPropertiesCreate(d *schema.ResourceData, m interface{}) error {
+	clients := m.(*config.AggregatedClient)
+	projectID, err := uuid.Parse(d.Get("project_id").(string))
+	if err != nil {
+		return fmt.Errorf("Invalid project UUID: %s", d.Get("project_id").(string))
+	}
+
+	patch := expandProjectPropertiesPatch(map[string]interface{}{}, d.Get("properties").(map[string]interface{}))
+	if err := setProjectProperties(clients, &projectID, patch); err != nil {
+		return fmt.Errorf("Error setting properties of project %s: %+v", projectID.String(), err)
+	}
+
+	d.SetId(projectID.String())
+	return resourceProjectPropertiesRead(d, m)
+}
+
+func resourceProjectPropertiesRead(d *schema.ResourceData, m interface{}) error {
+	clients := m.(*config.AggregatedClient)
+	projectID, err := uuid.Parse(d.Id())
+	if err != nil {
+		return fmt.Errorf("Invalid project UUID: %s", d.Id())
+	}
+
+	// only the properties known to the state are read back, so that properties managed
+	// outside of Terraform do not cause a diff
+	var keys []string
+	for key := range d.Get("properties").(map[string]interface{}) {
+		keys = append(keys, key)
+	}
+
+	// without any keys the service returns all properties, so nothing is read if the state does not contain any
+	properties := &[]core.ProjectProperty{}
+	if len(keys) > 0 {
+		properties, err = readProjectProperties(clients, &projectID, keys)
+		if err != nil {
+			return fmt.Errorf("Error reading properties of project %s: %+v", projectID.String(), err)
+		}
+	}
+
+	d.Set("project_id", projectID.String())
+	d.Set("properties", flattenProjectProperties(withoutSystemProjectProperties(properties)))
+	return nil
+}
+
+func resourceProjectPropertiesUpdate(d *schema.ResourceData, m interface{}) error {
+	clients := m.(*config.AggregatedClient)
+	projectID, err := uuid.Parse(d.Id())
+	if err != nil {
+		return fmt.Errorf("Invalid project UUID: %s", d.Id())
+	}
+
+	if d.HasChange("properties") {
+		oldProperties, newProperties := d.GetChange("properties")
+		patch := expandProjectPropertiesPatch(oldProperties.(map[string]interface{}), newProperties.(map[string]interface{}))
+		if err := setProjectProperties(clients, &projectID, patch); err != nil {
+			return fmt.Errorf("Error updating properties of project %s: %+v", projectID.String(), err)
+		}
+	}
+
+	return resourceProjectPropertiesRead(d, m)
+}
+
+func resourceProjectPropertiesDelete(d *schema.ResourceData, m interface{}) error {
+	clients := m.(*config.AggregatedClient)
+	projectID, err := uuid.Parse(d.Id())
+	if err != nil {
+		return fmt.Errorf("Invalid project UUID: %s", d.Id())
+	}
+
+	patch := expandProjectPropertiesPatch(d.Get("properties").(map[string]interface{}), map[string]interface{}{})
+	if err := setProjectProperties(clients, &projectID, patch); err != nil {
+		return fmt.Errorf("Error removing properties of project %s: %+v", projectID.String(), err)
+	}
+
+	d.SetId("")
+	return nil
+}
+
+// Imports all properties of a project that are not maintained by Azure DevOps itself
+func importProjectProperties(d *schema.ResourceData, m interface{}) ([]*schema.ResourceData, error) {
+	clients := m.(*config.AggregatedClient)
+	projectID, err := uuid.Parse(d.Id())
+	if err != nil {
+		return nil, fmt.Errorf("Project properties are imported by the project UUID. Invalid project UUID: %s", d.Id())
+	}
+
+	properties, err := readProjectProperties(clients, &projectID, nil)
+	if err != nil {
+		return nil, fmt.Errorf("Error reading properties of project %s: %+v", projectID.String(), err)
+	}
+
+	d.Set("project_id", projectID.String())
+	d.Set("properties", flattenProjectProperties(withoutSystemProjectProperties(properties)))
+	return []*schema.ResourceData{d}, nil
+}
+
+// Removes the properties that are maintained by Azure DevOps itself
+func withoutSystemProjectProperties(properties *[]core.ProjectProperty) *[]core.ProjectProperty {
+	userProperties := []core.ProjectProperty{}
+	if properties == nil {
+		return &userProperties
+	}
+	for _, property := range *properties {
+		if !strings.HasPrefix(converter.ToString(property.Name, ""), systemProjectPropertyPrefix) {
+			userProperties = append(userProperties, property)
+		}
+	}
+	return &userProperties
+}
+
+func validateProjectPropertyNames(i interface{}, key string) (_ []string, errors []error) {
+	for name := range i.(map[string]interface{}) {
+		if strings.TrimSpace(name) == "" {
+			errors = append(errors, fmt.Errorf("%q must not contain empty property names", key))
+		} else if strings.HasPrefix(name, systemProjectPropertyPrefix) {
+			errors = append(errors, fmt.Errorf("%q must not contain system property %q", key, name))
+		}
+	}
+	return nil, errors
+}
+
+func readProjectProperties(clients *config.AggregatedClient, projectID *uuid.UUID, keys []string) (*[]core.ProjectProperty, error) {
+	args := core.GetProjectPropertiesArgs{
+		ProjectId: projectID,
+	}
+	if len(keys) > 0 {
+		sort.Strings(keys)
+		args.Keys = &keys
+	}
+	return clients.CoreClient.GetProjectProperties(clients.Ctx, args)
+}
+
+func setProjectProperties(clients *config.AggregatedClient, projectID *uuid.UUID, patch []webapi.JsonPatchOperation) error {
+	if len(patch) == 0 {
+		return nil
+	}
+	return clients.CoreClient.SetProjectProperties(clients.Ctx, core.SetProjectPropertiesArgs{
+		ProjectId:     projectID,
+		PatchDocument: &patch,
+	})
+}
+
+// Computes the JSON patch document that transforms the old set of properties into the new one.
+// Azure DevOps only supports the Add and Remove verbs, where Add both creates and updates a property.
+func expandProjectPropertiesPatch(oldProperties map[string]interface{}, newProperties map[string]interface{}) []webapi.JsonPatchOperation {
+	var names []string
+	for name := range oldProperties {
+		names = append(names, name)
+	}
+	for name := range newProperties {
+		if _, ok := oldProperties[name]; !ok {
+			names = append(names, name)
+		}
+	}
+	sort.Strings(names)
+
+	patch := []webapi.JsonPatchOperation{}
+	for _, name := range names {
+		oldValue, inOld := oldProperties[name]
+		newValue, inNew := newProperties[name]
+		switch {
+		case inNew && (!inOld || oldValue != newValue):
+			patch = append(patch, webapi.JsonPatchOperation{
+				Op:    &webapi.OperationValues.Add,
+				Path:  converter.String("/" + name),
+				Value: newValue,
+			})
+		case inOld && !inNew:
+			patch = append(patch, webapi.JsonPatchOperation{
+				Op:   &webapi.OperationValues.Remove,
+				Path: converter.String("/" + name),
+			})
+		}
+	}
+	return patch
+}
+
+func flattenProjectProperties(properties *[]core.ProjectProperty) map[string]interface{} {
+	result := map[string]interface{}{}
+	if properties == nil {
+		return result
+	}
+	for _, property := range *properties {
+		if property.Name == nil {
+			continue
+		}
+		if property.Value == nil {
+			result[*property.Name] = ""
+		} else {
+			result[*property.Name] = fmt.Sprintf("%v", property.Value)
+		}
+	}
+	return result
+}
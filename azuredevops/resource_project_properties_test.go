@@ -0,0 +1,278 @@
+// +build all core resource_project_properties
+
+package azuredevops
+
+// The tests in this file use the mock clients in mock_client.go to mock out
+// the Azure DevOps client operations.
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/google/uuid"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/terraform"
+	"github.com/microsoft/azure-devops-go-api/azuredevops/core"
+	"github.com/microsoft/azure-devops-go-api/azuredevops/webapi"
+	"github.com/microsoft/terraform-provider-azuredevops/azdosdkmocks"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/utils/config"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/utils/converter"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/utils/testhelper"
+	"github.com/stretchr/testify/require"
+)
+
+var testProjectPropertiesProjectID = uuid.New()
+
+/**
+ * Begin unit tests
+ */
+
+// verifies that the patch document adds new and changed properties and removes deleted ones
+func TestAzureDevOpsProjectProperties_ExpandPatch_AddsAndRemoves(t *testing.T) {
+	oldProperties := map[string]interface{}{
+		"Owner":      "alice",
+		"CostCentre": "cc-1234",
+		"Obsolete":   "true",
+	}
+	newProperties := map[string]interface{}{
+		"Owner":          "bob",
+		"CostCentre":     "cc-1234",
+		"Classification": "internal",
+	}
+
+	patch := expandProjectPropertiesPatch(oldProperties, newProperties)
+	require.Equal(t, []webapi.JsonPatchOperation{
+		{Op: &webapi.OperationValues.Add, Path: converter.String("/Classification"), Value: "internal"},
+		{Op: &webapi.OperationValues.Remove, Path: converter.String("/Obsolete")},
+		{Op: &webapi.OperationValues.Add, Path: converter.String("/Owner"), Value: "bob"},
+	}, patch)
+
+	require.Empty(t, expandProjectPropertiesPatch(newProperties, newProperties))
+}
+
+// verifies that the create operation does not swallow errors
+func TestAzureDevOpsProjectProperties_Create_DoesNotSwallowError(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	coreClient := azdosdkmocks.NewMockCoreClient(ctrl)
+	clients := &config.AggregatedClient{
+		CoreClient: coreClient,
+		Ctx:        context.Background(),
+	}
+
+	resourceData := schema.TestResourceDataRaw(t, resourceProjectProperties().Schema, nil)
+	resourceData.Set("project_id", testProjectPropertiesProjectID.String())
+	resourceData.Set("properties", map[string]interface{}{"Owner": "alice"})
+
+	coreClient.
+		EXPECT().
+		SetProjectProperties(clients.Ctx, core.SetProjectPropertiesArgs{
+			ProjectId: &testProjectPropertiesProjectID,
+			PatchDocument: &[]webapi.JsonPatchOperation{
+				{Op: &webapi.OperationValues.Add, Path: converter.String("/Owner"), Value: "alice"},
+			},
+		}).
+		Return(errors.New("SetProjectProperties() Failed")).
+		Times(1)
+
+	err := resourceProjectPropertiesCreate(resourceData, clients)
+	require.Contains(t, err.Error(), "SetProjectProperties() Failed")
+}
+
+// verifies that only the properties known to the state are read back
+func TestAzureDevOpsProjectProperties_Read_OnlyReadsManagedKeys(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	coreClient := azdosdkmocks.NewMockCoreClient(ctrl)
+	clients := &config.AggregatedClient{
+		CoreClient: coreClient,
+		Ctx:        context.Background(),
+	}
+
+	resourceData := schema.TestResourceDataRaw(t, resourceProjectProperties().Schema, nil)
+	resourceData.SetId(testProjectPropertiesProjectID.String())
+	resourceData.Set("properties", map[string]interface{}{"Owner": "alice", "CostCentre": "cc-1234"})
+
+	coreClient.
+		EXPECT().
+		GetProjectProperties(clients.Ctx, core.GetProjectPropertiesArgs{
+			ProjectId: &testProjectPropertiesProjectID,
+			Keys:      &[]string{"CostCentre", "Owner"},
+		}).
+		Return(&[]core.ProjectProperty{
+			{Name: converter.String("Owner"), Value: "bob"},
+		}, nil).
+		Times(1)
+
+	err := resourceProjectPropertiesRead(resourceData, clients)
+	require.Nil(t, err)
+	require.Equal(t, map[string]interface{}{"Owner": "bob"}, resourceData.Get("properties"))
+}
+
+// verifies that no properties are read if the state does not contain any, as the service would return all of them
+func TestAzureDevOpsProjectProperties_Read_ReadsNothingWithoutKeys(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	coreClient := azdosdkmocks.NewMockCoreClient(ctrl)
+	clients := &config.AggregatedClient{
+		CoreClient: coreClient,
+		Ctx:        context.Background(),
+	}
+
+	resourceData := schema.TestResourceDataRaw(t, resourceProjectProperties().Schema, nil)
+	resourceData.SetId(testProjectPropertiesProjectID.String())
+
+	coreClient.
+		EXPECT().
+		GetProjectProperties(gomock.Any(), gomock.Any()).
+		Times(0)
+
+	err := resourceProjectPropertiesRead(resourceData, clients)
+	require.Nil(t, err)
+	require.Empty(t, resourceData.Get("properties"))
+}
+
+// verifies that system properties are not imported
+func TestAzureDevOpsProjectProperties_Import_SkipsSystemProperties(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	coreClient := azdosdkmocks.NewMockCoreClient(ctrl)
+	clients := &config.AggregatedClient{
+		CoreClient: coreClient,
+		Ctx:        context.Background(),
+	}
+
+	resourceData := schema.TestResourceDataRaw(t, resourceProjectProperties().Schema, nil)
+	resourceData.SetId(testProjectPropertiesProjectID.String())
+
+	coreClient.
+		EXPECT().
+		GetProjectProperties(clients.Ctx, core.GetProjectPropertiesArgs{
+			ProjectId: &testProjectPropertiesProjectID,
+		}).
+		Return(&[]core.ProjectProperty{
+			{Name: converter.String("System.CurrentProcessTemplateId"), Value: uuid.New().String()},
+			{Name: converter.String("Owner"), Value: "alice"},
+		}, nil).
+		Times(1)
+
+	_, err := importProjectProperties(resourceData, clients)
+	require.Nil(t, err)
+	require.Equal(t, map[string]interface{}{"Owner": "alice"}, resourceData.Get("properties"))
+	require.Equal(t, testProjectPropertiesProjectID.String(), resourceData.Get("project_id"))
+}
+
+// verifies that system properties cannot be configured
+func TestAzureDevOpsProjectProperties_Validate_RejectsSystemProperties(t *testing.T) {
+	_, errs := validateProjectPropertyNames(map[string]interface{}{"Owner": "alice"}, "properties")
+	require.Empty(t, errs)
+
+	_, errs = validateProjectPropertyNames(map[string]interface{}{"System.Process Template": "Agile"}, "properties")
+	require.Equal(t, 1, len(errs))
+}
+
+// verifies that the data source returns the properties matching the configured keys
+func TestDataSourceProjectProperties_Read_FiltersByKeys(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	coreClient := azdosdkmocks.NewMockCoreClient(ctrl)
+	clients := &config.AggregatedClient{
+		CoreClient: coreClient,
+		Ctx:        context.Background(),
+	}
+
+	resourceData := schema.TestResourceDataRaw(t, dataProjectProperties().Schema, nil)
+	resourceData.Set("project_id", testProjectPropertiesProjectID.String())
+	resourceData.Set("keys", []interface{}{"Owner"})
+
+	coreClient.
+		EXPECT().
+		GetProjectProperties(clients.Ctx, core.GetProjectPropertiesArgs{
+			ProjectId: &testProjectPropertiesProjectID,
+			Keys:      &[]string{"Owner"},
+		}).
+		Return(&[]core.ProjectProperty{
+			{Name: converter.String("Owner"), Value: "alice"},
+		}, nil).
+		Times(1)
+
+	err := dataSourceProjectPropertiesRead(resourceData, clients)
+	require.Nil(t, err)
+	require.Equal(t, testProjectPropertiesProjectID.String(), resourceData.Id())
+	require.Equal(t, map[string]interface{}{"Owner": "alice"}, resourceData.Get("properties"))
+}
+
+/**
+ * Begin acceptance tests
+ */
+
+// Verifies that properties can be set on a project, updated and read back through the data source
+func TestAccAzureDevOpsProjectProperties_CreateAndUpdate(t *testing.T) {
+	projectName := testhelper.TestAccResourcePrefix + acctest.RandStringFromCharSet(10, acctest.CharSetAlphaNum)
+	tfNode := "azuredevops_project_properties.properties"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testhelper.TestAccPreCheck(t, nil) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccProjectPropertiesCheckDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testhelper.TestAccProjectPropertiesResource(projectName, "alice"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet(tfNode, "project_id"),
+					resource.TestCheckResourceAttr(tfNode, "properties.%", "2"),
+					resource.TestCheckResourceAttr(tfNode, "properties.Owner", "alice"),
+					resource.TestCheckResourceAttr("data.azuredevops_project_properties.properties", "properties.Owner", "alice"),
+				),
+			},
+			{
+				Config: testhelper.TestAccProjectPropertiesResource(projectName, "bob"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(tfNode, "properties.Owner", "bob"),
+					resource.TestCheckResourceAttr("data.azuredevops_project_properties.properties", "properties.Owner", "bob"),
+				),
+			},
+		},
+	})
+}
+
+// verifies that the managed properties no longer exist on projects that survived the test
+func testAccProjectPropertiesCheckDestroy(s *terraform.State) error {
+	clients := testAccProvider.Meta().(*config.AggregatedClient)
+
+	for _, resource := range s.RootModule().Resources {
+		if resource.Type != "azuredevops_project_properties" {
+			continue
+		}
+
+		projectID, err := uuid.Parse(resource.Primary.ID)
+		if err != nil {
+			return err
+		}
+
+		properties, err := readProjectProperties(clients, &projectID, []string{"Owner", "CostCentre"})
+		if err != nil {
+			// the project itself has been deleted
+			continue
+		}
+		if len(*properties) > 0 {
+			return fmt.Errorf("Properties of project %s should not exist", projectID.String())
+		}
+	}
+
+	return nil
+}
+
+func init() {
+	InitProvider()
+}
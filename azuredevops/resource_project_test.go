@@ -355,28 +355,6 @@ func testAccCheckProjectResourceExists(expectedName string) resource.TestCheckFu
 
 }
 
-// verifies that all projects referenced in the state are destroyed. This will be invoked
-// *after* terrafform destroys the resource but *before* the state is wiped clean.
-func testAccProjectCheckDestroy(s *terraform.State) error {
-	clients := testAccProvider.Meta().(*config.AggregatedClient)
-
-	// verify that every project referenced in the state does not exist in AzDO
-	for _, resource := range s.RootModule().Resources {
-		if resource.Type != "azuredevops_project" {
-			continue
-		}
-
-		id := resource.Primary.ID
-
-		// indicates the project still exists - this should fail the test
-		if _, err := projectRead(clients, id, ""); err == nil {
-			return fmt.Errorf("project with ID %s should not exist", id)
-		}
-	}
-
-	return nil
-}
-
 func init() {
 	InitProvider()
 }
@@ -0,0 +1,138 @@
+package azuredevops
+
+import (
+	"fmt"
+	"strconv"
+
+	"github.com/google/uuid"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/microsoft/azure-devops-go-api/azuredevops/policy"
+	crud "github.com/microsoft/terraform-provider-azuredevops/azuredevops/crud/branchpolicy"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/utils/config"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/utils/converter"
+)
+
+// Case enforcement is part of the Git repository settings policy
+var gitRepositorySettingsPolicyType = uuid.MustParse("0517f88d-4ec5-4343-9d26-9930ebd53069")
+
+const enforceConsistentCase = "enforce_consistent_case"
+
+func resourceRepositoryPolicyCaseEnforcement() *schema.Resource {
+	r := crud.GenBasePolicyResource(&crud.PolicyCrudArgs{
+		PolicyType:      gitRepositorySettingsPolicyType,
+		RepositoryScope: true,
+		ExpandFunc:      expandCaseEnforcementPolicySettings,
+		FlattenFunc:     flattenCaseEnforcementPolicySettings,
+	})
+	r.Create = genCaseEnforcementCreateFunc(r.Create, r.Update)
+	r.Delete = genCaseEnforcementDeleteFunc(r.Delete)
+
+	crud.SettingsSchema(r)[enforceConsistentCase] = &schema.Schema{
+		Type:     schema.TypeBool,
+		Optional: true,
+		Default:  true,
+	}
+	return r
+}
+
+func expandCaseEnforcementPolicySettings(clients *config.AggregatedClient, tfSettings map[string]interface{}, policySettings map[string]interface{}) error {
+	policySettings["enforceConsistentCase"] = tfSettings[enforceConsistentCase].(bool)
+	return nil
+}
+
+func flattenCaseEnforcementPolicySettings(clients *config.AggregatedClient, policySettings map[string]interface{}, tfSettings map[string]interface{}) error {
+	tfSettings[enforceConsistentCase] = crud.GetBool(policySettings, "enforceConsistentCase")
+	return nil
+}
+
+// The settings policy of a repository also holds the forking and GVFS settings of the repository resource. If case
+// enforcement applies to a single repository that already has a settings policy, that policy is updated instead of
+// creating a second one.
+func genCaseEnforcementCreateFunc(createPolicy schema.CreateFunc, updatePolicy schema.UpdateFunc) schema.CreateFunc {
+	return func(d *schema.ResourceData, m interface{}) error {
+		clients := m.(*config.AggregatedClient)
+		repoID := getCaseEnforcementRepositoryID(d)
+		if repoID == "" {
+			return createPolicy(d, m)
+		}
+
+		settingsPolicy, err := getAzureGitRepositorySettingsPolicy(clients, d.Get(crud.SchemaProjectID).(string), repoID)
+		if err != nil {
+			return err
+		}
+		if settingsPolicy == nil {
+			return createPolicy(d, m)
+		}
+
+		d.SetId(strconv.Itoa(*settingsPolicy.Id))
+		if err := updatePolicy(d, m); err != nil {
+			d.SetId("")
+			return err
+		}
+		return nil
+	}
+}
+
+// Returns the repository if case enforcement applies to a single repository, or an empty string otherwise
+func getCaseEnforcementRepositoryID(d *schema.ResourceData) string {
+	scopes := d.Get(crud.SchemaSettings + ".0.scope").([]interface{})
+	if len(scopes) != 1 {
+		return ""
+	}
+	// empty scope blocks are read as nil
+	scopeValues, _ := scopes[0].(map[string]interface{})
+	repoID, _ := scopeValues["repository_id"].(string)
+	return repoID
+}
+
+// Only case enforcement is removed from a settings policy that holds other settings as well, e.g. whether forks are
+// allowed. The policy is deleted if no other settings remain.
+func genCaseEnforcementDeleteFunc(deletePolicy schema.DeleteFunc) schema.DeleteFunc {
+	return func(d *schema.ResourceData, m interface{}) error {
+		clients := m.(*config.AggregatedClient)
+		projectID := converter.String(d.Get(crud.SchemaProjectID).(string))
+		policyID, err := strconv.Atoi(d.Id())
+		if err != nil {
+			return fmt.Errorf("Error parsing policy configuration ID %s: %+v", d.Id(), err)
+		}
+
+		policyConfig, err := clients.PolicyClient.GetPolicyConfiguration(clients.Ctx, policy.GetPolicyConfigurationArgs{
+			Project:         projectID,
+			ConfigurationId: &policyID,
+		})
+		if err != nil {
+			return fmt.Errorf("Error looking up policy configuration with ID %d and project ID %s: %+v", policyID, *projectID, err)
+		}
+		if policyConfig == nil {
+			return deletePolicy(d, m)
+		}
+
+		settings, _ := policyConfig.Settings.(map[string]interface{})
+		delete(settings, "enforceConsistentCase")
+		if !hasSettingsBesidesScope(settings) {
+			return deletePolicy(d, m)
+		}
+
+		policyConfig.Settings = settings
+		_, err = clients.PolicyClient.UpdatePolicyConfiguration(clients.Ctx, policy.UpdatePolicyConfigurationArgs{
+			Configuration:   policyConfig,
+			Project:         projectID,
+			ConfigurationId: &policyID,
+		})
+		if err != nil {
+			return fmt.Errorf("Error updating policy in Azure DevOps: %+v", err)
+		}
+
+		d.SetId("")
+		return nil
+	}
+}
+
+func hasSettingsBesidesScope(settings map[string]interface{}) bool {
+	for key := range settings {
+		if key != "scope" {
+			return true
+		}
+	}
+	return false
+}
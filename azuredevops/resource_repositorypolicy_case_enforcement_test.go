@@ -0,0 +1,249 @@
+// +build all core resource_repositorypolicy_case_enforcement
+
+package azuredevops
+
+// The tests in this file use the mock clients in mock_client.go to mock out
+// the Azure DevOps client operations.
+
+import (
+	"context"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/microsoft/azure-devops-go-api/azuredevops/policy"
+	"github.com/microsoft/terraform-provider-azuredevops/azdosdkmocks"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/utils/config"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/utils/converter"
+	"github.com/stretchr/testify/require"
+)
+
+/**
+ * Begin unit tests
+ */
+
+// verifies that the flatten/expand round trip yields the same case enforcement settings
+func TestRepositoryPolicyCaseEnforcement_ExpandFlatten_Roundtrip(t *testing.T) {
+	for _, enforce := range []bool{true, false} {
+		// settings as they are decoded from the service response
+		policySettings := map[string]interface{}{
+			"enforceConsistentCase": enforce,
+		}
+
+		tfSettings := map[string]interface{}{}
+		require.Nil(t, flattenCaseEnforcementPolicySettings(nil, policySettings, tfSettings))
+		require.Equal(t, enforce, tfSettings["enforce_consistent_case"])
+
+		policySettingsAfterRoundTrip := map[string]interface{}{}
+		require.Nil(t, expandCaseEnforcementPolicySettings(nil, tfSettings, policySettingsAfterRoundTrip))
+		require.Equal(t, policySettings, policySettingsAfterRoundTrip)
+	}
+}
+
+// verifies that the settings of the shared repository settings policy that are managed by the repository resource
+// are kept when case enforcement is updated
+func TestRepositoryPolicyCaseEnforcement_Update_KeepsRepositorySettings(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	policyClient := azdosdkmocks.NewMockPolicyClient(ctrl)
+	clients := &config.AggregatedClient{PolicyClient: policyClient, Ctx: context.Background()}
+
+	resourceData := schema.TestResourceDataRaw(t, resourceRepositoryPolicyCaseEnforcement().Schema, nil)
+	resourceData.SetId("5")
+	resourceData.Set("project_id", testPolicyProjectID)
+	resourceData.Set("settings", []interface{}{map[string]interface{}{
+		"enforce_consistent_case": false,
+		"scope": []interface{}{
+			map[string]interface{}{"repository_id": testPolicyRepoID},
+		},
+	}})
+
+	// settings as they are decoded from the service response
+	existingPolicy := &policy.PolicyConfiguration{
+		Id:   converter.Int(5),
+		Type: &policy.PolicyTypeRef{Id: &gitRepositorySettingsPolicyType},
+		Settings: map[string]interface{}{
+			"enforceConsistentCase": true,
+			"allowedForkTargets":    float64(0),
+			"gvfsOnly":              true,
+			"scope": []interface{}{
+				map[string]interface{}{"repositoryId": testPolicyRepoID},
+			},
+		},
+	}
+
+	policyClient.
+		EXPECT().
+		GetPolicyConfiguration(clients.Ctx, policy.GetPolicyConfigurationArgs{
+			Project:         converter.String(testPolicyProjectID),
+			ConfigurationId: converter.Int(5),
+		}).
+		Return(existingPolicy, nil).
+		Times(1)
+	policyClient.
+		EXPECT().
+		UpdatePolicyConfiguration(clients.Ctx, gomock.Any()).
+		DoAndReturn(func(ctx context.Context, args policy.UpdatePolicyConfigurationArgs) (*policy.PolicyConfiguration, error) {
+			settings := args.Configuration.Settings.(map[string]interface{})
+			require.Equal(t, false, settings["enforceConsistentCase"])
+			require.Equal(t, float64(0), settings["allowedForkTargets"])
+			require.Equal(t, true, settings["gvfsOnly"])
+			require.Equal(t, []map[string]interface{}{{"repositoryId": testPolicyRepoID}}, settings["scope"])
+			return args.Configuration, nil
+		}).
+		Times(1)
+
+	err := resourceRepositoryPolicyCaseEnforcement().Update(resourceData, clients)
+	require.Nil(t, err)
+	require.Equal(t, false, resourceData.Get("settings.0.enforce_consistent_case"))
+}
+
+// verifies that case enforcement of a repository is added to the existing settings policy of the repository instead
+// of creating a second policy
+func TestRepositoryPolicyCaseEnforcement_Create_MergesIntoRepositorySettingsPolicy(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	policyClient := azdosdkmocks.NewMockPolicyClient(ctrl)
+	clients := &config.AggregatedClient{PolicyClient: policyClient, Ctx: context.Background()}
+
+	resourceData := schema.TestResourceDataRaw(t, resourceRepositoryPolicyCaseEnforcement().Schema, nil)
+	resourceData.Set("project_id", testPolicyProjectID)
+	resourceData.Set("settings", []interface{}{map[string]interface{}{
+		"enforce_consistent_case": true,
+		"scope": []interface{}{
+			map[string]interface{}{"repository_id": testPolicyRepoID},
+		},
+	}})
+
+	existingPolicy := policy.PolicyConfiguration{
+		Id:   converter.Int(5),
+		Type: &policy.PolicyTypeRef{Id: &gitRepositorySettingsPolicyType},
+		Settings: map[string]interface{}{
+			"allowedForkTargets": float64(0),
+			"scope": []interface{}{
+				map[string]interface{}{"repositoryId": testPolicyRepoID},
+			},
+		},
+	}
+
+	policyClient.
+		EXPECT().
+		GetPolicyConfigurations(clients.Ctx, gomock.Any()).
+		Return(&policy.GetPolicyConfigurationsResponseValue{Value: []policy.PolicyConfiguration{existingPolicy}}, nil).
+		Times(1)
+	policyClient.
+		EXPECT().
+		GetPolicyConfiguration(clients.Ctx, gomock.Any()).
+		Return(&existingPolicy, nil).
+		Times(1)
+	policyClient.
+		EXPECT().
+		CreatePolicyConfiguration(gomock.Any(), gomock.Any()).
+		Times(0)
+	policyClient.
+		EXPECT().
+		UpdatePolicyConfiguration(clients.Ctx, gomock.Any()).
+		DoAndReturn(func(ctx context.Context, args policy.UpdatePolicyConfigurationArgs) (*policy.PolicyConfiguration, error) {
+			require.Equal(t, 5, *args.ConfigurationId)
+			settings := args.Configuration.Settings.(map[string]interface{})
+			require.Equal(t, true, settings["enforceConsistentCase"])
+			require.Equal(t, float64(0), settings["allowedForkTargets"])
+			return args.Configuration, nil
+		}).
+		Times(1)
+
+	err := resourceRepositoryPolicyCaseEnforcement().Create(resourceData, clients)
+	require.Nil(t, err)
+	require.Equal(t, "5", resourceData.Id())
+}
+
+// verifies that only case enforcement is removed from a settings policy that holds settings of the repository
+func TestRepositoryPolicyCaseEnforcement_Delete_KeepsRepositorySettings(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	policyClient := azdosdkmocks.NewMockPolicyClient(ctrl)
+	clients := &config.AggregatedClient{PolicyClient: policyClient, Ctx: context.Background()}
+
+	resourceData := schema.TestResourceDataRaw(t, resourceRepositoryPolicyCaseEnforcement().Schema, nil)
+	resourceData.SetId("5")
+	resourceData.Set("project_id", testPolicyProjectID)
+
+	policyClient.
+		EXPECT().
+		GetPolicyConfiguration(clients.Ctx, gomock.Any()).
+		Return(&policy.PolicyConfiguration{
+			Id: converter.Int(5),
+			Settings: map[string]interface{}{
+				"enforceConsistentCase": true,
+				"gvfsOnly":              true,
+				"scope": []interface{}{
+					map[string]interface{}{"repositoryId": testPolicyRepoID},
+				},
+			},
+		}, nil).
+		Times(1)
+	policyClient.
+		EXPECT().
+		DeletePolicyConfiguration(gomock.Any(), gomock.Any()).
+		Times(0)
+	policyClient.
+		EXPECT().
+		UpdatePolicyConfiguration(clients.Ctx, gomock.Any()).
+		DoAndReturn(func(ctx context.Context, args policy.UpdatePolicyConfigurationArgs) (*policy.PolicyConfiguration, error) {
+			settings := args.Configuration.Settings.(map[string]interface{})
+			require.NotContains(t, settings, "enforceConsistentCase")
+			require.Equal(t, true, settings["gvfsOnly"])
+			return args.Configuration, nil
+		}).
+		Times(1)
+
+	err := resourceRepositoryPolicyCaseEnforcement().Delete(resourceData, clients)
+	require.Nil(t, err)
+	require.Equal(t, "", resourceData.Id())
+}
+
+// verifies that the settings policy is deleted if case enforcement is its only setting
+func TestRepositoryPolicyCaseEnforcement_Delete_DeletesPolicyWithoutOtherSettings(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	policyClient := azdosdkmocks.NewMockPolicyClient(ctrl)
+	clients := &config.AggregatedClient{PolicyClient: policyClient, Ctx: context.Background()}
+
+	resourceData := schema.TestResourceDataRaw(t, resourceRepositoryPolicyCaseEnforcement().Schema, nil)
+	resourceData.SetId("5")
+	resourceData.Set("project_id", testPolicyProjectID)
+
+	policyClient.
+		EXPECT().
+		GetPolicyConfiguration(clients.Ctx, gomock.Any()).
+		Return(&policy.PolicyConfiguration{
+			Id: converter.Int(5),
+			Settings: map[string]interface{}{
+				"enforceConsistentCase": true,
+				"scope": []interface{}{
+					map[string]interface{}{"repositoryId": testPolicyRepoID},
+				},
+			},
+		}, nil).
+		Times(1)
+	policyClient.
+		EXPECT().
+		DeletePolicyConfiguration(clients.Ctx, policy.DeletePolicyConfigurationArgs{
+			Project:         converter.String(testPolicyProjectID),
+			ConfigurationId: converter.Int(5),
+		}).
+		Return(nil).
+		Times(1)
+
+	err := resourceRepositoryPolicyCaseEnforcement().Delete(resourceData, clients)
+	require.Nil(t, err)
+	require.Equal(t, "", resourceData.Id())
+}
+
+func init() {
+	InitProvider()
+}
@@ -0,0 +1,47 @@
+package azuredevops
+
+import (
+	"github.com/google/uuid"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	crud "github.com/microsoft/terraform-provider-azuredevops/azuredevops/crud/branchpolicy"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/utils/config"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/utils/validate"
+)
+
+var filePathPatternsPolicyType = uuid.MustParse("51c78909-e838-41a2-9496-c647091e3c61")
+
+const filePathPatterns = "filepath_patterns"
+
+func resourceRepositoryPolicyFilePathPatterns() *schema.Resource {
+	r := crud.GenBasePolicyResource(&crud.PolicyCrudArgs{
+		PolicyType:      filePathPatternsPolicyType,
+		RepositoryScope: true,
+		ExpandFunc:      expandFilePathPatternsPolicySettings,
+		FlattenFunc:     flattenFilePathPatternsPolicySettings,
+	})
+
+	crud.SettingsSchema(r)[filePathPatterns] = &schema.Schema{
+		Type:     schema.TypeList,
+		Required: true,
+		MinItems: 1,
+		Elem: &schema.Schema{
+			Type:         schema.TypeString,
+			ValidateFunc: validate.NoEmptyStrings,
+		},
+	}
+	return r
+}
+
+func expandFilePathPatternsPolicySettings(clients *config.AggregatedClient, tfSettings map[string]interface{}, policySettings map[string]interface{}) error {
+	patterns := []string{}
+	for _, pattern := range tfSettings[filePathPatterns].([]interface{}) {
+		patterns = append(patterns, pattern.(string))
+	}
+	policySettings["filenamePatterns"] = patterns
+	return nil
+}
+
+func flattenFilePathPatternsPolicySettings(clients *config.AggregatedClient, policySettings map[string]interface{}, tfSettings map[string]interface{}) error {
+	tfSettings[filePathPatterns] = crud.GetStrings(policySettings, "filenamePatterns")
+	return nil
+}
@@ -0,0 +1,34 @@
+// +build all core resource_repositorypolicy_file_path_patterns
+
+package azuredevops
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+/**
+ * Begin unit tests
+ */
+
+// verifies that the flatten/expand round trip yields the same file path patterns
+func TestRepositoryPolicyFilePathPatterns_ExpandFlatten_Roundtrip(t *testing.T) {
+	// settings as they are decoded from the service response
+	policySettings := map[string]interface{}{
+		"filenamePatterns": []interface{}{"*.exe", "/bin/*"},
+	}
+
+	tfSettings := map[string]interface{}{}
+	require.Nil(t, flattenFilePathPatternsPolicySettings(nil, policySettings, tfSettings))
+	require.Equal(t, []string{"*.exe", "/bin/*"}, tfSettings["filepath_patterns"])
+
+	tfSettings["filepath_patterns"] = []interface{}{"*.exe", "/bin/*"}
+	policySettingsAfterRoundTrip := map[string]interface{}{}
+	require.Nil(t, expandFilePathPatternsPolicySettings(nil, tfSettings, policySettingsAfterRoundTrip))
+	require.Equal(t, []string{"*.exe", "/bin/*"}, policySettingsAfterRoundTrip["filenamePatterns"])
+}
+
+func init() {
+	InitProvider()
+}
@@ -0,0 +1,52 @@
+package azuredevops
+
+import (
+	"github.com/google/uuid"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/validation"
+	crud "github.com/microsoft/terraform-provider-azuredevops/azuredevops/crud/branchpolicy"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/utils/config"
+)
+
+var maxFileSizePolicyType = uuid.MustParse("2e26e725-8201-4edd-8bf5-978563c34a80")
+
+const (
+	maxFileSize                = "max_file_size"
+	maxFileSizeUseUncompressed = "use_uncompressed_size"
+	bytesPerMegabyte           = 1024 * 1024
+)
+
+func resourceRepositoryPolicyMaxFileSize() *schema.Resource {
+	r := crud.GenBasePolicyResource(&crud.PolicyCrudArgs{
+		PolicyType:      maxFileSizePolicyType,
+		RepositoryScope: true,
+		ExpandFunc:      expandMaxFileSizePolicySettings,
+		FlattenFunc:     flattenMaxFileSizePolicySettings,
+	})
+
+	settings := crud.SettingsSchema(r)
+	// the service only accepts these limits, in megabytes
+	settings[maxFileSize] = &schema.Schema{
+		Type:         schema.TypeInt,
+		Required:     true,
+		ValidateFunc: validation.IntInSlice([]int{1, 2, 5, 10, 50, 100, 200}),
+	}
+	settings[maxFileSizeUseUncompressed] = &schema.Schema{
+		Type:     schema.TypeBool,
+		Optional: true,
+		Default:  false,
+	}
+	return r
+}
+
+func expandMaxFileSizePolicySettings(clients *config.AggregatedClient, tfSettings map[string]interface{}, policySettings map[string]interface{}) error {
+	policySettings["maximumGitBlobSizeInBytes"] = tfSettings[maxFileSize].(int) * bytesPerMegabyte
+	policySettings["useUncompressedSize"] = tfSettings[maxFileSizeUseUncompressed].(bool)
+	return nil
+}
+
+func flattenMaxFileSizePolicySettings(clients *config.AggregatedClient, policySettings map[string]interface{}, tfSettings map[string]interface{}) error {
+	tfSettings[maxFileSize] = crud.GetInt(policySettings, "maximumGitBlobSizeInBytes") / bytesPerMegabyte
+	tfSettings[maxFileSizeUseUncompressed] = crud.GetBool(policySettings, "useUncompressedSize")
+	return nil
+}
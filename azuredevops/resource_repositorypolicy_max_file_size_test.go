@@ -0,0 +1,130 @@
+// +build all core resource_repositorypolicy_max_file_size
+
+package azuredevops
+
+// The tests in this file use the mock clients in mock_client.go to mock out
+// the Azure DevOps client operations.
+
+import (
+	"context"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/microsoft/azure-devops-go-api/azuredevops/policy"
+	"github.com/microsoft/terraform-provider-azuredevops/azdosdkmocks"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/utils/config"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/utils/converter"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/utils/testhelper"
+	"github.com/stretchr/testify/require"
+)
+
+/**
+ * Begin unit tests
+ */
+
+// verifies that repository policies are scoped to repositories only and that the size is converted into bytes
+func TestRepositoryPolicyMaxFileSize_Create_ScopesRepositories(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	policyClient := azdosdkmocks.NewMockPolicyClient(ctrl)
+	clients := &config.AggregatedClient{PolicyClient: policyClient, Ctx: context.Background()}
+
+	resourceData := schema.TestResourceDataRaw(t, resourceRepositoryPolicyMaxFileSize().Schema, nil)
+	resourceData.Set("project_id", testPolicyProjectID)
+	resourceData.Set("settings", []interface{}{map[string]interface{}{
+		"max_file_size": 10,
+		"scope": []interface{}{
+			map[string]interface{}{"repository_id": testPolicyRepoID},
+			map[string]interface{}{},
+		},
+	}})
+
+	policyClient.
+		EXPECT().
+		CreatePolicyConfiguration(clients.Ctx, gomock.Any()).
+		DoAndReturn(func(ctx context.Context, args policy.CreatePolicyConfigurationArgs) (*policy.PolicyConfiguration, error) {
+			require.Equal(t, maxFileSizePolicyType, *args.Configuration.Type.Id)
+
+			settings := args.Configuration.Settings.(map[string]interface{})
+			require.Equal(t, 10*1024*1024, settings["maximumGitBlobSizeInBytes"])
+			require.Equal(t, false, settings["useUncompressedSize"])
+			require.Equal(t, []map[string]interface{}{
+				{"repositoryId": testPolicyRepoID},
+				{"repositoryId": nil},
+			}, settings["scope"])
+
+			// settings as they are decoded from the service response
+			return &policy.PolicyConfiguration{
+				Id:         converter.Int(8),
+				IsEnabled:  args.Configuration.IsEnabled,
+				IsBlocking: args.Configuration.IsBlocking,
+				Type:       args.Configuration.Type,
+				Settings: map[string]interface{}{
+					"maximumGitBlobSizeInBytes": float64(10 * 1024 * 1024),
+					"useUncompressedSize":       false,
+					"scope": []interface{}{
+						map[string]interface{}{"repositoryId": testPolicyRepoID},
+						map[string]interface{}{"repositoryId": nil},
+					},
+				},
+			}, nil
+		}).
+		Times(1)
+
+	err := resourceRepositoryPolicyMaxFileSize().Create(resourceData, clients)
+	require.Nil(t, err)
+	require.Equal(t, "8", resourceData.Id())
+	require.Equal(t, 10, resourceData.Get("settings.0.max_file_size"))
+	require.Equal(t, testPolicyRepoID, resourceData.Get("settings.0.scope.0.repository_id"))
+	require.Equal(t, "", resourceData.Get("settings.0.scope.1.repository_id"))
+}
+
+/**
+ * Begin acceptance tests
+ */
+
+// Verifies that the repository policies can be created for a repository and for a project
+func TestAccRepositoryPolicies_CreateAndUpdate(t *testing.T) {
+	projectName := testhelper.TestAccResourcePrefix + acctest.RandStringFromCharSet(10, acctest.CharSetAlphaNum)
+	gitRepoName := testhelper.TestAccResourcePrefix + acctest.RandStringFromCharSet(10, acctest.CharSetAlphaNum)
+	maxFileSizeNode := "azuredevops_repository_policy_max_file_size.policy"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testhelper.TestAccPreCheck(t, nil) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccBranchPolicyCheckDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testhelper.TestAccRepositoryPolicyResources(projectName, gitRepoName, 10),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(maxFileSizeNode, "settings.0.max_file_size", "10"),
+					resource.TestCheckResourceAttrPair(maxFileSizeNode, "settings.0.scope.0.repository_id", "azuredevops_azure_git_repository.gitrepo", "id"),
+					resource.TestCheckResourceAttr("azuredevops_repository_policy_max_path_length.policy", "settings.0.max_path_length", "248"),
+					resource.TestCheckResourceAttr("azuredevops_repository_policy_file_path_patterns.policy", "settings.0.filepath_patterns.#", "2"),
+					resource.TestCheckResourceAttrSet("azuredevops_repository_policy_reserved_names.policy", "id"),
+					resource.TestCheckResourceAttr("azuredevops_repository_policy_case_enforcement.policy", "settings.0.enforce_consistent_case", "true"),
+				),
+			},
+			{
+				Config: testhelper.TestAccRepositoryPolicyResources(projectName, gitRepoName, 50),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(maxFileSizeNode, "settings.0.max_file_size", "50"),
+				),
+			},
+			{
+				ResourceName:      maxFileSizeNode,
+				ImportStateIdFunc: testAccBranchPolicyImportStateIDFunc(maxFileSizeNode),
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func init() {
+	InitProvider()
+}
@@ -0,0 +1,39 @@
+package azuredevops
+
+import (
+	"github.com/google/uuid"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/validation"
+	crud "github.com/microsoft/terraform-provider-azuredevops/azuredevops/crud/branchpolicy"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/utils/config"
+)
+
+var maxPathLengthPolicyType = uuid.MustParse("001a79cf-fda1-4c4e-9e7c-bac40ee5ead8")
+
+const maxPathLength = "max_path_length"
+
+func resourceRepositoryPolicyMaxPathLength() *schema.Resource {
+	r := crud.GenBasePolicyResource(&crud.PolicyCrudArgs{
+		PolicyType:      maxPathLengthPolicyType,
+		RepositoryScope: true,
+		ExpandFunc:      expandMaxPathLengthPolicySettings,
+		FlattenFunc:     flattenMaxPathLengthPolicySettings,
+	})
+
+	crud.SettingsSchema(r)[maxPathLength] = &schema.Schema{
+		Type:         schema.TypeInt,
+		Required:     true,
+		ValidateFunc: validation.IntAtLeast(1),
+	}
+	return r
+}
+
+func expandMaxPathLengthPolicySettings(clients *config.AggregatedClient, tfSettings map[string]interface{}, policySettings map[string]interface{}) error {
+	policySettings["maxPathLength"] = tfSettings[maxPathLength].(int)
+	return nil
+}
+
+func flattenMaxPathLengthPolicySettings(clients *config.AggregatedClient, policySettings map[string]interface{}, tfSettings map[string]interface{}) error {
+	tfSettings[maxPathLength] = crud.GetInt(policySettings, "maxPathLength")
+	return nil
+}
@@ -0,0 +1,33 @@
+// +build all core resource_repositorypolicy_max_path_length
+
+package azuredevops
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+/**
+ * Begin unit tests
+ */
+
+// verifies that the flatten/expand round trip yields the same maximum path length
+func TestRepositoryPolicyMaxPathLength_ExpandFlatten_Roundtrip(t *testing.T) {
+	// settings as they are decoded from the service response
+	policySettings := map[string]interface{}{
+		"maxPathLength": float64(248),
+	}
+
+	tfSettings := map[string]interface{}{}
+	require.Nil(t, flattenMaxPathLengthPolicySettings(nil, policySettings, tfSettings))
+	require.Equal(t, 248, tfSettings["max_path_length"])
+
+	policySettingsAfterRoundTrip := map[string]interface{}{}
+	require.Nil(t, expandMaxPathLengthPolicySettings(nil, tfSettings, policySettingsAfterRoundTrip))
+	require.Equal(t, 248, policySettingsAfterRoundTrip["maxPathLength"])
+}
+
+func init() {
+	InitProvider()
+}
@@ -0,0 +1,18 @@
+package azuredevops
+
+import (
+	"github.com/google/uuid"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	crud "github.com/microsoft/terraform-provider-azuredevops/azuredevops/crud/branchpolicy"
+)
+
+var reservedNamesPolicyType = uuid.MustParse("db2b9b4c-180d-4529-9701-01541d19f36b")
+
+// The policy has no settings besides its scope. It rejects pushes that add files or folders with names that are
+// reserved on some platforms, e.g. CON or AUX on Windows.
+func resourceRepositoryPolicyReservedNames() *schema.Resource {
+	return crud.GenBasePolicyResource(&crud.PolicyCrudArgs{
+		PolicyType:      reservedNamesPolicyType,
+		RepositoryScope: true,
+	})
+}
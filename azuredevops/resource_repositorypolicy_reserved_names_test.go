@@ -0,0 +1,73 @@
+// +build all core resource_repositorypolicy_reserved_names
+
+package azuredevops
+
+// The tests in this file use the mock clients in mock_client.go to mock out
+// the Azure DevOps client operations.
+
+import (
+	"context"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/microsoft/azure-devops-go-api/azuredevops/policy"
+	"github.com/microsoft/terraform-provider-azuredevops/azdosdkmocks"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/utils/config"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/utils/converter"
+	"github.com/stretchr/testify/require"
+)
+
+/**
+ * Begin unit tests
+ */
+
+// verifies that the policy is created with its scope as the only setting
+func TestRepositoryPolicyReservedNames_Create_OnlySetsScope(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	policyClient := azdosdkmocks.NewMockPolicyClient(ctrl)
+	clients := &config.AggregatedClient{PolicyClient: policyClient, Ctx: context.Background()}
+
+	resourceData := schema.TestResourceDataRaw(t, resourceRepositoryPolicyReservedNames().Schema, nil)
+	resourceData.Set("project_id", testPolicyProjectID)
+	resourceData.Set("settings", []interface{}{map[string]interface{}{
+		"scope": []interface{}{
+			map[string]interface{}{"repository_id": testPolicyRepoID},
+		},
+	}})
+
+	policyClient.
+		EXPECT().
+		CreatePolicyConfiguration(clients.Ctx, gomock.Any()).
+		DoAndReturn(func(ctx context.Context, args policy.CreatePolicyConfigurationArgs) (*policy.PolicyConfiguration, error) {
+			require.Equal(t, reservedNamesPolicyType, *args.Configuration.Type.Id)
+			require.Equal(t, map[string]interface{}{
+				"scope": []map[string]interface{}{{"repositoryId": testPolicyRepoID}},
+			}, args.Configuration.Settings)
+
+			// settings as they are decoded from the service response
+			return &policy.PolicyConfiguration{
+				Id:         converter.Int(9),
+				IsEnabled:  args.Configuration.IsEnabled,
+				IsBlocking: args.Configuration.IsBlocking,
+				Type:       args.Configuration.Type,
+				Settings: map[string]interface{}{
+					"scope": []interface{}{
+						map[string]interface{}{"repositoryId": testPolicyRepoID},
+					},
+				},
+			}, nil
+		}).
+		Times(1)
+
+	err := resourceRepositoryPolicyReservedNames().Create(resourceData, clients)
+	require.Nil(t, err)
+	require.Equal(t, "9", resourceData.Id())
+	require.Equal(t, testPolicyRepoID, resourceData.Get("settings.0.scope.0.repository_id"))
+}
+
+func init() {
+	InitProvider()
+}
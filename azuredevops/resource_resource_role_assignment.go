@@ -0,0 +1,307 @@
+package azuredevops
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+
+	"github.com/google/uuid"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/validation"
+	"github.com/microsoft/azure-devops-go-api/azuredevops/core"
+	"github.com/microsoft/azure-devops-go-api/azuredevops/identity"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/utils/config"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/utils/converter"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/utils/validate"
+)
+
+// Types of resources that are secured through role assignments
+const (
+	roleAssignmentResourceVariableGroup   = "variablegroup"
+	roleAssignmentResourceServiceEndpoint = "serviceendpoint"
+	roleAssignmentResourceAgentQueue      = "agentqueue"
+	roleAssignmentResourceAgentPool       = "agentpool"
+)
+
+// Location of the role assignments API (_apis/securityroles/scopes/{scopeId}/roleassignments/resources/{resourceId})
+var roleAssignmentsLocationID = uuid.MustParse("9461c234-c84c-4ed2-b918-2f0f92ad0a35")
+
+const roleAssignmentsAPIVersion = "5.1-preview.1"
+
+// azDORoleAssignment is a role assignment as returned by the security roles API
+type azDORoleAssignment struct {
+	Access   *string `json:"access,omitempty"`
+	Identity *struct {
+		ID          *string `json:"id,omitempty"`
+		DisplayName *string `json:"displayName,omitempty"`
+	} `json:"identity,omitempty"`
+	Role *struct {
+		Name *string `json:"name,omitempty"`
+	} `json:"role,omitempty"`
+}
+
+// azDOUserRoleAssignment is a role assignment as sent to the security roles API
+type azDOUserRoleAssignment struct {
+	RoleName string `json:"roleName"`
+	UserID   string `json:"userId"`
+}
+
+func resourceResourceRoleAssignment() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceResourceRoleAssignmentCreateOrUpdate,
+		Read:   resourceResourceRoleAssignmentRead,
+		Update: resourceResourceRoleAssignmentCreateOrUpdate,
+		Delete: resourceResourceRoleAssignmentDelete,
+		Importer: &schema.ResourceImporter{
+			State: importResourceRoleAssignment,
+		},
+		Schema: map[string]*schema.Schema{
+			"resource_type": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+				ValidateFunc: validation.StringInSlice([]string{
+					roleAssignmentResourceVariableGroup,
+					roleAssignmentResourceServiceEndpoint,
+					roleAssignmentResourceAgentQueue,
+					roleAssignmentResourceAgentPool,
+				}, false),
+			},
+			"project_id": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				ValidateFunc: validate.UUID,
+			},
+			"resource_id": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				ValidateFunc: validate.NoEmptyStrings,
+			},
+			"principal": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validate.NoEmptyStrings,
+			},
+			"role_name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringInSlice([]string{"Reader", "User", "Administrator", "Creator"}, false),
+			},
+			"identity_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func resourceResourceRoleAssignmentCreateOrUpdate(d *schema.ResourceData, m interface{}) error {
+	clients := m.(*config.AggregatedClient)
+	scopeID, resourceID, err := getRoleAssignmentScope(d)
+	if err != nil {
+		return err
+	}
+	identityID, err := getPrincipalIdentityID(clients, d.Get("principal").(string))
+	if err != nil {
+		return err
+	}
+
+	err = setRoleAssignments(clients.Ctx, clients.CoreClient, scopeID, resourceID, []azDOUserRoleAssignment{{
+		RoleName: d.Get("role_name").(string),
+		UserID:   identityID,
+	}})
+	if err != nil {
+		return fmt.Errorf("Error assigning role %s on %s %s: %+v", d.Get("role_name").(string), scopeID, resourceID, err)
+	}
+
+	d.SetId(fmt.Sprintf("%s/%s/%s", scopeID, resourceID, identityID))
+	return resourceResourceRoleAssignmentRead(d, m)
+}
+
+func resourceResourceRoleAssignmentRead(d *schema.ResourceData, m interface{}) error {
+	clients := m.(*config.AggregatedClient)
+	scopeID, resourceID, err := getRoleAssignmentScope(d)
+	if err != nil {
+		return err
+	}
+	identityID, err := getPrincipalIdentityID(clients, d.Get("principal").(string))
+	if err != nil {
+		return err
+	}
+
+	assignments, err := getRoleAssignments(clients.Ctx, clients.CoreClient, scopeID, resourceID)
+	if err != nil {
+		return fmt.Errorf("Error reading role assignments on %s %s: %+v", scopeID, resourceID, err)
+	}
+
+	for _, assignment := range assignments {
+		// roles inherited from the parent scope cannot be managed on the resource
+		if assignment.Identity == nil || !strings.EqualFold(converter.ToString(assignment.Identity.ID, ""), identityID) ||
+			!strings.EqualFold(converter.ToString(assignment.Access, ""), "assigned") {
+			continue
+		}
+		if assignment.Role != nil {
+			d.Set("role_name", converter.ToString(assignment.Role.Name, ""))
+		}
+		d.Set("identity_id", identityID)
+		return nil
+	}
+
+	d.SetId("")
+	return nil
+}
+
+func resourceResourceRoleAssignmentDelete(d *schema.ResourceData, m interface{}) error {
+	clients := m.(*config.AggregatedClient)
+	scopeID, resourceID, err := getRoleAssignmentScope(d)
+	if err != nil {
+		return err
+	}
+	identityID, err := getPrincipalIdentityID(clients, d.Get("principal").(string))
+	if err != nil {
+		return err
+	}
+
+	if err := removeRoleAssignments(clients.Ctx, clients.CoreClient, scopeID, resourceID, []string{identityID}); err != nil {
+		return fmt.Errorf("Error removing role assignment on %s %s: %+v", scopeID, resourceID, err)
+	}
+
+	d.SetId("")
+	return nil
+}
+
+// Import ID is of the form <resource type>/<project ID or empty>/<resource ID or empty>/<principal descriptor>,
+// e.g. variablegroup/782a8123-1019-xxxx-xxxx-xxxxxxxx/12/vssgp.xxx
+func importResourceRoleAssignment(d *schema.ResourceData, m interface{}) ([]*schema.ResourceData, error) {
+	parts := strings.Split(d.Id(), "/")
+	if len(parts) != 4 || parts[0] == "" || parts[3] == "" {
+		return nil, fmt.Errorf("Error parsing import ID %s. Expected format <resource type>/<project ID>/<resource ID>/<principal descriptor>", d.Id())
+	}
+
+	d.Set("resource_type", parts[0])
+	d.Set("project_id", parts[1])
+	d.Set("resource_id", parts[2])
+	d.Set("principal", parts[3])
+
+	clients := m.(*config.AggregatedClient)
+	scopeID, resourceID, err := getRoleAssignmentScope(d)
+	if err != nil {
+		return nil, err
+	}
+	identityID, err := getPrincipalIdentityID(clients, parts[3])
+	if err != nil {
+		return nil, err
+	}
+
+	d.SetId(fmt.Sprintf("%s/%s/%s", scopeID, resourceID, identityID))
+	return []*schema.ResourceData{d}, nil
+}
+
+// Maps the resource type and IDs to the scope and resource ID of the security roles API. Without a resource ID,
+// the roles are assigned on the project library, on all service endpoints or agent queues of a project, or on
+// all agent pools of the organization.
+func getRoleAssignmentScope(d *schema.ResourceData) (string, string, error) {
+	resourceType := d.Get("resource_type").(string)
+	projectID := d.Get("project_id").(string)
+	resourceID := d.Get("resource_id").(string)
+
+	if resourceType == roleAssignmentResourceAgentPool {
+		if projectID != "" {
+			return "", "", fmt.Errorf("Agent pools are organization resources and do not take a project_id")
+		}
+		if resourceID == "" {
+			return "distributedtask.globalagentpoolrole", "0", nil
+		}
+		return "distributedtask.agentpoolrole", resourceID, nil
+	}
+
+	if projectID == "" {
+		return "", "", fmt.Errorf("A project_id is required for role assignments on resources of type %s", resourceType)
+	}
+	switch resourceType {
+	case roleAssignmentResourceVariableGroup:
+		if resourceID == "" {
+			return "distributedtask.library", projectID + "$0", nil
+		}
+		return "distributedtask.variablegroup", projectID + "$" + resourceID, nil
+	case roleAssignmentResourceServiceEndpoint:
+		if resourceID == "" {
+			return "distributedtask.project.serviceendpointrole", projectID, nil
+		}
+		return "distributedtask.serviceendpointrole", projectID + "_" + resourceID, nil
+	case roleAssignmentResourceAgentQueue:
+		if resourceID == "" {
+			return "distributedtask.globalagentqueuerole", projectID, nil
+		}
+		return "distributedtask.agentqueuerole", projectID + "_" + resourceID, nil
+	}
+	return "", "", fmt.Errorf("Unsupported resource type %s", resourceType)
+}
+
+// Resolves a graph subject descriptor into the ID of the identity, which the security roles API uses
+func getPrincipalIdentityID(clients *config.AggregatedClient, principal string) (string, error) {
+	identities, err := clients.IdentityClient.ReadIdentities(clients.Ctx, identity.ReadIdentitiesArgs{
+		SubjectDescriptors: converter.String(principal),
+	})
+	if err != nil {
+		return "", fmt.Errorf("Error resolving identity of principal %s: %+v", principal, err)
+	}
+	if identities == nil || len(*identities) != 1 || (*identities)[0].Id == nil {
+		return "", fmt.Errorf("Could not resolve identity of principal %s", principal)
+	}
+	return (*identities)[0].Id.String(), nil
+}
+
+func getRoleAssignments(ctx context.Context, client core.Client, scopeID string, resourceID string) ([]azDORoleAssignment, error) {
+	var assignments []azDORoleAssignment
+	err := sendRoleAssignmentsRequest(ctx, client, http.MethodGet, scopeID, resourceID, nil, &assignments)
+	return assignments, err
+}
+
+func setRoleAssignments(ctx context.Context, client core.Client, scopeID string, resourceID string, assignments []azDOUserRoleAssignment) error {
+	return sendRoleAssignmentsRequest(ctx, client, http.MethodPut, scopeID, resourceID, assignments, nil)
+}
+
+func removeRoleAssignments(ctx context.Context, client core.Client, scopeID string, resourceID string, identityIDs []string) error {
+	return sendRoleAssignmentsRequest(ctx, client, http.MethodPatch, scopeID, resourceID, identityIDs, nil)
+}
+
+// The security roles API is not part of the Azure DevOps Go SDK, so requests are sent through the core client.
+// If result is set, the collection returned by the API is unmarshalled into it.
+func sendRoleAssignmentsRequest(ctx context.Context, client core.Client, method string, scopeID string, resourceID string, body interface{}, result interface{}) error {
+	clientImpl, ok := client.(*core.ClientImpl)
+	if !ok {
+		return fmt.Errorf("Invalid Azure DevOps Core client implementation")
+	}
+
+	routeValues := map[string]string{
+		"scopeId":    scopeID,
+		"resourceId": resourceID,
+	}
+	var requestBody io.Reader
+	mediaType := ""
+	if body != nil {
+		content, err := json.Marshal(body)
+		if err != nil {
+			return err
+		}
+		requestBody = bytes.NewReader(content)
+		mediaType = "application/json"
+	}
+
+	resp, err := clientImpl.Client.Send(ctx, method, roleAssignmentsLocationID, roleAssignmentsAPIVersion, routeValues, nil, requestBody, mediaType, "application/json", nil)
+	if err != nil {
+		return err
+	}
+	if result == nil {
+		return nil
+	}
+	return clientImpl.Client.UnmarshalCollectionBody(resp, result)
+}
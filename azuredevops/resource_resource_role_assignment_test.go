@@ -0,0 +1,142 @@
+// +build all security resource_resource_role_assignment
+
+package azuredevops
+
+// The tests in this file use the mock clients in mock_client.go to mock out
+// the Azure DevOps client operations.
+
+import (
+	"context"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/google/uuid"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/microsoft/azure-devops-go-api/azuredevops/identity"
+	"github.com/microsoft/terraform-provider-azuredevops/azdosdkmocks"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/utils/config"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/utils/converter"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/utils/testhelper"
+	"github.com/stretchr/testify/require"
+)
+
+var testRoleAssignmentProjectID = uuid.New()
+
+/**
+ * Begin unit tests
+ */
+
+// verifies that resource types and IDs are mapped to the scopes and resource IDs of the security roles API
+func TestAzureDevOpsResourceRoleAssignment_Scope(t *testing.T) {
+	projectID := testRoleAssignmentProjectID.String()
+	tests := []struct {
+		resourceType string
+		projectID    string
+		resourceID   string
+		scopeID      string
+		scopeResource string
+	}{
+		{roleAssignmentResourceVariableGroup, projectID, "", "distributedtask.library", projectID + "$0"},
+		{roleAssignmentResourceVariableGroup, projectID, "12", "distributedtask.variablegroup", projectID + "$12"},
+		{roleAssignmentResourceServiceEndpoint, projectID, "", "distributedtask.project.serviceendpointrole", projectID},
+		{roleAssignmentResourceServiceEndpoint, projectID, "abc", "distributedtask.serviceendpointrole", projectID + "_abc"},
+		{roleAssignmentResourceAgentQueue, projectID, "", "distributedtask.globalagentqueuerole", projectID},
+		{roleAssignmentResourceAgentQueue, projectID, "3", "distributedtask.agentqueuerole", projectID + "_3"},
+		{roleAssignmentResourceAgentPool, "", "", "distributedtask.globalagentpoolrole", "0"},
+		{roleAssignmentResourceAgentPool, "", "9", "distributedtask.agentpoolrole", "9"},
+	}
+
+	for _, test := range tests {
+		resourceData := schema.TestResourceDataRaw(t, resourceResourceRoleAssignment().Schema, nil)
+		resourceData.Set("resource_type", test.resourceType)
+		resourceData.Set("project_id", test.projectID)
+		resourceData.Set("resource_id", test.resourceID)
+
+		scopeID, resourceID, err := getRoleAssignmentScope(resourceData)
+		require.Nil(t, err)
+		require.Equal(t, test.scopeID, scopeID)
+		require.Equal(t, test.scopeResource, resourceID)
+	}
+}
+
+// verifies that the project is required for project resources and rejected for agent pools
+func TestAzureDevOpsResourceRoleAssignment_Scope_ValidatesProject(t *testing.T) {
+	resourceData := schema.TestResourceDataRaw(t, resourceResourceRoleAssignment().Schema, nil)
+	resourceData.Set("resource_type", roleAssignmentResourceVariableGroup)
+	_, _, err := getRoleAssignmentScope(resourceData)
+	require.NotNil(t, err)
+
+	resourceData.Set("resource_type", roleAssignmentResourceAgentPool)
+	resourceData.Set("project_id", testRoleAssignmentProjectID.String())
+	_, _, err = getRoleAssignmentScope(resourceData)
+	require.NotNil(t, err)
+}
+
+// verifies that an unexpected client implementation results in an error instead of a panic
+func TestAzureDevOpsResourceRoleAssignment_Create_ReturnsErrorForMockClient(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	identityClient := azdosdkmocks.NewMockIdentityClient(ctrl)
+	clients := &config.AggregatedClient{
+		CoreClient:     azdosdkmocks.NewMockCoreClient(ctrl),
+		IdentityClient: identityClient,
+		Ctx:            context.Background(),
+	}
+
+	resourceData := schema.TestResourceDataRaw(t, resourceResourceRoleAssignment().Schema, nil)
+	resourceData.Set("resource_type", roleAssignmentResourceVariableGroup)
+	resourceData.Set("project_id", testRoleAssignmentProjectID.String())
+	resourceData.Set("resource_id", "12")
+	resourceData.Set("principal", "vssgp.readers")
+	resourceData.Set("role_name", "User")
+
+	identityID := uuid.New()
+	identityClient.
+		EXPECT().
+		ReadIdentities(clients.Ctx, identity.ReadIdentitiesArgs{SubjectDescriptors: converter.String("vssgp.readers")}).
+		Return(&[]identity.Identity{{Id: &identityID}}, nil).
+		Times(1)
+
+	err := resourceResourceRoleAssignmentCreateOrUpdate(resourceData, clients)
+	require.Contains(t, err.Error(), "Invalid Azure DevOps Core client implementation")
+}
+
+/**
+ * Begin acceptance tests
+ */
+
+// Verifies that roles can be assigned on a variable group and on the project library
+func TestAccAzureDevOpsResourceRoleAssignment_CreateAndUpdate(t *testing.T) {
+	projectName := testhelper.TestAccResourcePrefix + acctest.RandStringFromCharSet(10, acctest.CharSetAlphaNum)
+	variableGroupName := testhelper.TestAccResourcePrefix + acctest.RandStringFromCharSet(10, acctest.CharSetAlphaNum)
+	tfNode := "azuredevops_resource_role_assignment.variable_group"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testhelper.TestAccPreCheck(t, nil) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccProjectCheckDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testhelper.TestAccResourceRoleAssignmentResource(projectName, variableGroupName, "User"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(tfNode, "role_name", "User"),
+					resource.TestCheckResourceAttrSet(tfNode, "identity_id"),
+					resource.TestCheckResourceAttr("azuredevops_resource_role_assignment.library", "role_name", "Creator"),
+				),
+			},
+			{
+				Config: testhelper.TestAccResourceRoleAssignmentResource(projectName, variableGroupName, "Administrator"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(tfNode, "role_name", "Administrator"),
+				),
+			},
+		},
+	})
+}
+
+func init() {
+	InitProvider()
+}
@@ -0,0 +1,488 @@
+package azuredevops
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/google/uuid"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/microsoft/azure-devops-go-api/azuredevops"
+	"github.com/microsoft/azure-devops-go-api/azuredevops/security"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/utils/config"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/utils/converter"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/utils/validate"
+)
+
+// Values that can be assigned to the actions of a security namespace
+const (
+	permissionAllow  = "Allow"
+	permissionDeny   = "Deny"
+	permissionNotSet = "NotSet"
+)
+
+func resourceSecurityPermissions() *schema.Resource {
+	return genSecurityPermissionsResourceForTarget(map[string]*schema.Schema{
+		"namespace_id": {
+			Type:         schema.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: validate.UUID,
+		},
+		"token": {
+			Type:         schema.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: validate.NoEmptyStrings,
+		},
+	}, getSecurityPermissionsTarget, parseSecurityPermissionsImportID)
+}
+
+// securityPermissionsSchema returns the arguments shared by all resources that manage the
+// access control entry of a principal on a security token
+func securityPermissionsSchema() map[string]*schema.Schema {
+	return map[string]*schema.Schema{
+		"principal": {
+			Type:         schema.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: validate.NoEmptyStrings,
+		},
+		"permissions": {
+			Type:         schema.TypeMap,
+			Required:     true,
+			ValidateFunc: validatePermissionValues,
+			Elem: &schema.Schema{
+				Type: schema.TypeString,
+			},
+		},
+		"replace": {
+			Type:     schema.TypeBool,
+			Optional: true,
+			Default:  true,
+		},
+		"inherit": {
+			Type:     schema.TypeBool,
+			Optional: true,
+			Default:  true,
+		},
+	}
+}
+
+// securityTokenFunc builds the security token addressed by a permissions resource from the arguments of the resource
+type securityTokenFunc func(d *schema.ResourceData, clients *config.AggregatedClient) (string, error)
+
+// securityTargetFunc returns the security namespace and the token addressed by a permissions resource
+type securityTargetFunc func(d *schema.ResourceData, clients *config.AggregatedClient) (*uuid.UUID, string, error)
+
+// securityTargetNotFoundError is returned by token functions if the object addressed by a permissions resource
+// does not exist anymore, so that the resource is removed from the state instead of failing
+type securityTargetNotFoundError struct {
+	message string
+}
+
+func (e *securityTargetNotFoundError) Error() string {
+	return e.message
+}
+
+func isSecurityTargetNotFoundError(err error) bool {
+	_, ok := err.(*securityTargetNotFoundError)
+	return ok
+}
+
+// genSecurityPermissionsResource creates a resource managing the permissions of a principal on the tokens of a single
+// security namespace. The token is built from the target arguments of the resource, which force a new resource.
+// The parse function of the importer sets the target arguments and the principal from the import ID.
+func genSecurityPermissionsResource(namespaceID uuid.UUID, target map[string]*schema.Schema, createToken securityTokenFunc, parseImportID func(d *schema.ResourceData) error) *schema.Resource {
+	return genSecurityPermissionsResourceForTarget(target, func(d *schema.ResourceData, clients *config.AggregatedClient) (*uuid.UUID, string, error) {
+		token, err := createToken(d, clients)
+		if err != nil {
+			return nil, "", err
+		}
+		return &namespaceID, token, nil
+	}, parseImportID)
+}
+
+// genSecurityPermissionsResourceForTarget creates a resource managing the permissions of a principal on the token
+// returned by the target function, which may also read the security namespace from the arguments of the resource
+func genSecurityPermissionsResourceForTarget(target map[string]*schema.Schema, getTarget securityTargetFunc, parseImportID func(d *schema.ResourceData) error) *schema.Resource {
+	s := securityPermissionsSchema()
+	for k, v := range target {
+		s[k] = v
+	}
+
+	read := func(d *schema.ResourceData, m interface{}) error {
+		clients := m.(*config.AggregatedClient)
+		namespaceID, token, err := getTarget(d, clients)
+		if isSecurityTargetNotFoundError(err) {
+			d.SetId("")
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+		return readSecurityPermissions(d, clients, namespaceID, token)
+	}
+
+	createOrUpdate := func(d *schema.ResourceData, m interface{}) error {
+		clients := m.(*config.AggregatedClient)
+		namespaceID, token, err := getTarget(d, clients)
+		if err != nil {
+			return err
+		}
+		if err := setSecurityPermissions(d, clients, namespaceID, token); err != nil {
+			return err
+		}
+
+		d.SetId(fmt.Sprintf("%s/%s/%s", namespaceID.String(), token, d.Get("principal").(string)))
+		return read(d, m)
+	}
+
+	return &schema.Resource{
+		Create: createOrUpdate,
+		Read:   read,
+		Update: createOrUpdate,
+		Delete: func(d *schema.ResourceData, m interface{}) error {
+			clients := m.(*config.AggregatedClient)
+			namespaceID, token, err := getTarget(d, clients)
+			if isSecurityTargetNotFoundError(err) {
+				d.SetId("")
+				return nil
+			}
+			if err != nil {
+				return err
+			}
+			if err := removeSecurityPermissions(d, clients, namespaceID, token); err != nil {
+				return err
+			}
+
+			d.SetId("")
+			return nil
+		},
+		Importer: &schema.ResourceImporter{
+			State: func(d *schema.ResourceData, m interface{}) ([]*schema.ResourceData, error) {
+				if err := parseImportID(d); err != nil {
+					return nil, err
+				}
+				clients, _ := m.(*config.AggregatedClient)
+				namespaceID, token, err := getTarget(d, clients)
+				if err != nil {
+					return nil, err
+				}
+
+				d.SetId(fmt.Sprintf("%s/%s/%s", namespaceID.String(), token, d.Get("principal").(string)))
+				d.Set("replace", true)
+				d.Set("inherit", true)
+				return []*schema.ResourceData{d}, nil
+			},
+		},
+		Schema: s,
+	}
+}
+
+func validatePermissionValues(i interface{}, key string) (_ []string, errors []error) {
+	for name, value := range i.(map[string]interface{}) {
+		switch value.(string) {
+		case permissionAllow, permissionDeny, permissionNotSet:
+		default:
+			errors = append(errors, fmt.Errorf("%q: permission %s must be one of %s, %s or %s, got %q", key, name, permissionAllow, permissionDeny, permissionNotSet, value))
+		}
+	}
+	return nil, errors
+}
+
+// Import ID is of the form <namespace ID>/<token>/<principal descriptor>. The token itself may contain slashes.
+func parseSecurityPermissionsImportID(d *schema.ResourceData) error {
+	id := d.Id()
+	first := strings.Index(id, "/")
+	last := strings.LastIndex(id, "/")
+	if first <= 0 || last <= first+1 || last == len(id)-1 {
+		return fmt.Errorf("Error parsing import ID %s. Expected format <namespace ID>/<token>/<principal descriptor>", id)
+	}
+
+	d.Set("namespace_id", id[:first])
+	d.Set("token", id[first+1:last])
+	d.Set("principal", id[last+1:])
+	return nil
+}
+
+func getSecurityPermissionsTarget(d *schema.ResourceData, clients *config.AggregatedClient) (*uuid.UUID, string, error) {
+	namespaceID, err := uuid.Parse(d.Get("namespace_id").(string))
+	if err != nil {
+		return nil, "", fmt.Errorf("Error parsing security namespace ID: %+v", err)
+	}
+	return &namespaceID, d.Get("token").(string), nil
+}
+
+// setSecurityPermissions writes the configured permissions of the principal on the token. If replace is set, the
+// access control entry of the principal is made to match the configuration exactly; otherwise only the configured
+// actions (and the actions removed from the configuration) are changed.
+func setSecurityPermissions(d *schema.ResourceData, clients *config.AggregatedClient, namespaceID *uuid.UUID, token string) error {
+	actions, err := getSecurityNamespaceActions(clients, namespaceID)
+	if err != nil {
+		return err
+	}
+	descriptor, err := getPrincipalIdentityDescriptor(clients, d.Get("principal").(string))
+	if err != nil {
+		return err
+	}
+
+	allow, deny, managed, err := expandPermissions(d.Get("permissions").(map[string]interface{}), actions)
+	if err != nil {
+		return err
+	}
+	if d.Get("replace").(bool) {
+		for _, bit := range actions {
+			managed |= bit
+		}
+	} else if d.HasChange("permissions") {
+		old, _ := d.GetChange("permissions")
+		for name := range old.(map[string]interface{}) {
+			managed |= actions[name]
+		}
+	}
+
+	if err := writeAccessControlEntry(clients, namespaceID, token, descriptor, allow, deny, managed); err != nil {
+		return fmt.Errorf("Error setting permissions on token %s: %+v", token, err)
+	}
+
+	return setAccessControlListInheritance(clients, namespaceID, token, d.Get("inherit").(bool))
+}
+
+// readSecurityPermissions reads the permissions of the principal on the token. Unless replace is set, only the
+// actions present in the state are read, so that permissions managed elsewhere do not show up as drift.
+func readSecurityPermissions(d *schema.ResourceData, clients *config.AggregatedClient, namespaceID *uuid.UUID, token string) error {
+	actions, err := getSecurityNamespaceActions(clients, namespaceID)
+	if err != nil {
+		return err
+	}
+	descriptor, err := getPrincipalIdentityDescriptor(clients, d.Get("principal").(string))
+	if err != nil {
+		return err
+	}
+
+	acl, err := getAccessControlList(clients, namespaceID, token)
+	if err != nil {
+		return fmt.Errorf("Error reading permissions on token %s: %+v", token, err)
+	}
+
+	ace, _ := getAccessControlEntry(acl, descriptor)
+	d.Set("permissions", flattenPermissions(&ace, actions, d.Get("permissions").(map[string]interface{}), d.Get("replace").(bool)))
+	d.Set("inherit", acl == nil || converter.ToBool(acl.InheritPermissions, true))
+	return nil
+}
+
+// removeSecurityPermissions removes the permissions managed by the resource and turns inheritance back on
+func removeSecurityPermissions(d *schema.ResourceData, clients *config.AggregatedClient, namespaceID *uuid.UUID, token string) error {
+	descriptor, err := getPrincipalIdentityDescriptor(clients, d.Get("principal").(string))
+	if err != nil {
+		return err
+	}
+
+	if d.Get("replace").(bool) {
+		err = removeAccessControlEntry(clients, namespaceID, token, descriptor)
+	} else {
+		var actions map[string]int
+		actions, err = getSecurityNamespaceActions(clients, namespaceID)
+		if err != nil {
+			return err
+		}
+		managed := 0
+		for name := range d.Get("permissions").(map[string]interface{}) {
+			managed |= actions[name]
+		}
+		err = writeAccessControlEntry(clients, namespaceID, token, descriptor, 0, 0, managed)
+	}
+	if err != nil {
+		return fmt.Errorf("Error removing permissions on token %s: %+v", token, err)
+	}
+
+	if !d.Get("inherit").(bool) {
+		return setAccessControlListInheritance(clients, namespaceID, token, true)
+	}
+	return nil
+}
+
+// Reads the actions of a security namespace as a map of action name to permission bit
+func getSecurityNamespaceActions(clients *config.AggregatedClient, namespaceID *uuid.UUID) (map[string]int, error) {
+	namespaces, err := clients.SecurityClient.QuerySecurityNamespaces(clients.Ctx, security.QuerySecurityNamespacesArgs{
+		SecurityNamespaceId: namespaceID,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("Error reading security namespace %s: %+v", namespaceID.String(), err)
+	}
+	if namespaces == nil || len(*namespaces) == 0 || (*namespaces)[0].Actions == nil {
+		return nil, fmt.Errorf("Security namespace %s does not exist", namespaceID.String())
+	}
+
+	actions := map[string]int{}
+	for _, action := range *(*namespaces)[0].Actions {
+		if action.Name != nil && action.Bit != nil {
+			actions[*action.Name] = *action.Bit
+		}
+	}
+	return actions, nil
+}
+
+func getPrincipalIdentityDescriptor(clients *config.AggregatedClient, principal string) (string, error) {
+	descriptors, err := getIdentityDescriptors(clients, []string{principal})
+	if err != nil {
+		return "", fmt.Errorf("Error resolving identity of principal %s: %+v", principal, err)
+	}
+	if len(descriptors) != 1 {
+		return "", fmt.Errorf("Could not resolve identity of principal %s", principal)
+	}
+	return descriptors[0], nil
+}
+
+// Reads the access control list of a token. Returns nil if no access control list exists for the token.
+func getAccessControlList(clients *config.AggregatedClient, namespaceID *uuid.UUID, token string) (*security.AccessControlList, error) {
+	acls, err := clients.SecurityClient.QueryAccessControlLists(clients.Ctx, security.QueryAccessControlListsArgs{
+		SecurityNamespaceId: namespaceID,
+		Token:               converter.String(token),
+	})
+	if err != nil {
+		return nil, err
+	}
+	if acls == nil {
+		return nil, nil
+	}
+	for _, acl := range *acls {
+		if acl.Token != nil && strings.EqualFold(*acl.Token, token) {
+			return &acl, nil
+		}
+	}
+	return nil, nil
+}
+
+func getAccessControlEntry(acl *security.AccessControlList, descriptor string) (security.AccessControlEntry, bool) {
+	if acl == nil || acl.AcesDictionary == nil {
+		return security.AccessControlEntry{}, false
+	}
+	for key, ace := range *acl.AcesDictionary {
+		if strings.EqualFold(key, descriptor) {
+			return ace, true
+		}
+	}
+	return security.AccessControlEntry{}, false
+}
+
+// Writes the access control entry of an identity. Only the managed bits of an existing entry are overwritten;
+// the entry is removed if no bits remain.
+func writeAccessControlEntry(clients *config.AggregatedClient, namespaceID *uuid.UUID, token string, descriptor string, allow int, deny int, managed int) error {
+	acl, err := getAccessControlList(clients, namespaceID, token)
+	if err != nil {
+		return err
+	}
+	if ace, ok := getAccessControlEntry(acl, descriptor); ok {
+		allow |= converter.ToInt(ace.Allow, 0) &^ managed
+		deny |= converter.ToInt(ace.Deny, 0) &^ managed
+	}
+
+	if allow == 0 && deny == 0 {
+		return removeAccessControlEntry(clients, namespaceID, token, descriptor)
+	}
+
+	_, err = clients.SecurityClient.SetAccessControlEntries(clients.Ctx, security.SetAccessControlEntriesArgs{
+		SecurityNamespaceId: namespaceID,
+		Container: map[string]interface{}{
+			"token": token,
+			"merge": false,
+			"accessControlEntries": []security.AccessControlEntry{{
+				Descriptor: converter.String(descriptor),
+				Allow:      converter.Int(allow),
+				Deny:       converter.Int(deny),
+			}},
+		},
+	})
+	return err
+}
+
+func removeAccessControlEntry(clients *config.AggregatedClient, namespaceID *uuid.UUID, token string, descriptor string) error {
+	_, err := clients.SecurityClient.RemoveAccessControlEntries(clients.Ctx, security.RemoveAccessControlEntriesArgs{
+		SecurityNamespaceId: namespaceID,
+		Token:               converter.String(token),
+		Descriptors:         converter.String(descriptor),
+	})
+	return err
+}
+
+// Turns the inheritance of permissions from the parent token on or off. The access control list is written
+// as a whole, so the entries of all identities on the token are written back unchanged.
+func setAccessControlListInheritance(clients *config.AggregatedClient, namespaceID *uuid.UUID, token string, inherit bool) error {
+	acl, err := getAccessControlList(clients, namespaceID, token)
+	if err != nil {
+		return fmt.Errorf("Error reading permissions on token %s: %+v", token, err)
+	}
+	if acl == nil {
+		if inherit {
+			return nil
+		}
+		acl = &security.AccessControlList{
+			Token:          converter.String(token),
+			AcesDictionary: &map[string]security.AccessControlEntry{},
+		}
+	} else if converter.ToBool(acl.InheritPermissions, true) == inherit {
+		return nil
+	}
+
+	acl.InheritPermissions = converter.Bool(inherit)
+	err = clients.SecurityClient.SetAccessControlLists(clients.Ctx, security.SetAccessControlListsArgs{
+		SecurityNamespaceId: namespaceID,
+		AccessControlLists: &azuredevops.VssJsonCollectionWrapper{
+			Count: converter.Int(1),
+			Value: &[]interface{}{acl},
+		},
+	})
+	if err != nil {
+		return fmt.Errorf("Error setting permission inheritance on token %s: %+v", token, err)
+	}
+	return nil
+}
+
+// Converts the configured permissions into the allow and deny bits of an access control entry. The managed
+// bits are the bits of all configured actions, including the ones that are not set.
+func expandPermissions(permissions map[string]interface{}, actions map[string]int) (allow int, deny int, managed int, err error) {
+	for name, value := range permissions {
+		bit, ok := actions[name]
+		if !ok {
+			names := make([]string, 0, len(actions))
+			for action := range actions {
+				names = append(names, action)
+			}
+			sort.Strings(names)
+			return 0, 0, 0, fmt.Errorf("Unknown permission %s. Valid permissions are %s", name, strings.Join(names, ", "))
+		}
+
+		managed |= bit
+		switch value.(string) {
+		case permissionAllow:
+			allow |= bit
+		case permissionDeny:
+			deny |= bit
+		}
+	}
+	return allow, deny, managed, nil
+}
+
+// Converts an access control entry into permissions. All actions in the current permissions are returned; if all
+// is set, every action that is allowed or denied is returned as well.
+func flattenPermissions(ace *security.AccessControlEntry, actions map[string]int, current map[string]interface{}, all bool) map[string]interface{} {
+	allow := converter.ToInt(ace.Allow, 0)
+	deny := converter.ToInt(ace.Deny, 0)
+
+	permissions := map[string]interface{}{}
+	for name, bit := range actions {
+		value := permissionNotSet
+		if allow&bit != 0 {
+			value = permissionAllow
+		} else if deny&bit != 0 {
+			value = permissionDeny
+		}
+
+		if _, ok := current[name]; ok || (all && value != permissionNotSet) {
+			permissions[name] = value
+		}
+	}
+	return permissions
+}
@@ -0,0 +1,277 @@
+// +build all security resource_security_permissions
+
+package azuredevops
+
+// The tests in this file use the mock clients in mock_client.go to mock out
+// the Azure DevOps client operations.
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/google/uuid"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/terraform"
+	"github.com/microsoft/azure-devops-go-api/azuredevops/identity"
+	"github.com/microsoft/azure-devops-go-api/azuredevops/security"
+	"github.com/microsoft/terraform-provider-azuredevops/azdosdkmocks"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/utils/config"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/utils/converter"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/utils/testhelper"
+	"github.com/stretchr/testify/require"
+)
+
+var testSecurityNamespaceID = uuid.New()
+
+const testSecurityToken = "$PROJECT:vstfs:///Classification/TeamProject/1"
+const testSecurityPrincipal = "vssgp.readers"
+const testSecurityIdentity = "Microsoft.TeamFoundation.Identity;S-1-readers"
+
+var testSecurityActions = map[string]int{
+	"GENERIC_READ":      1,
+	"DELETE":            4,
+	"MANAGE_PROPERTIES": 8,
+}
+
+/**
+ * Begin unit tests
+ */
+
+// verifies that the permissions are converted into allow and deny bits
+func TestAzureDevOpsSecurityPermissions_ExpandPermissions(t *testing.T) {
+	allow, deny, managed, err := expandPermissions(map[string]interface{}{
+		"GENERIC_READ":      permissionAllow,
+		"DELETE":            permissionDeny,
+		"MANAGE_PROPERTIES": permissionNotSet,
+	}, testSecurityActions)
+	require.Nil(t, err)
+	require.Equal(t, 1, allow)
+	require.Equal(t, 4, deny)
+	require.Equal(t, 13, managed)
+
+	_, _, _, err = expandPermissions(map[string]interface{}{"RENAME": permissionAllow}, testSecurityActions)
+	require.Equal(t, "Unknown permission RENAME. Valid permissions are DELETE, GENERIC_READ, MANAGE_PROPERTIES", err.Error())
+}
+
+// verifies that only the current permissions are read, unless all permissions are requested
+func TestAzureDevOpsSecurityPermissions_FlattenPermissions(t *testing.T) {
+	ace := security.AccessControlEntry{Allow: converter.Int(1), Deny: converter.Int(4)}
+
+	permissions := flattenPermissions(&ace, testSecurityActions, map[string]interface{}{"GENERIC_READ": permissionAllow, "MANAGE_PROPERTIES": permissionAllow}, false)
+	require.Equal(t, map[string]interface{}{"GENERIC_READ": permissionAllow, "MANAGE_PROPERTIES": permissionNotSet}, permissions)
+
+	permissions = flattenPermissions(&ace, testSecurityActions, map[string]interface{}{}, true)
+	require.Equal(t, map[string]interface{}{"GENERIC_READ": permissionAllow, "DELETE": permissionDeny}, permissions)
+}
+
+// verifies that bits of the principal that are not managed by the resource are kept when replace is not set
+func TestAzureDevOpsSecurityPermissions_Create_MergesUnmanagedPermissions(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	clients := newTestSecurityPermissionsClients(ctrl, &security.AccessControlEntry{
+		Descriptor: converter.String(testSecurityIdentity),
+		Allow:      converter.Int(8),
+		Deny:       converter.Int(1),
+	})
+	securityClient := clients.SecurityClient.(*azdosdkmocks.MockSecurityClient)
+
+	resourceData := schema.TestResourceDataRaw(t, resourceSecurityPermissions().Schema, nil)
+	resourceData.Set("namespace_id", testSecurityNamespaceID.String())
+	resourceData.Set("token", testSecurityToken)
+	resourceData.Set("principal", testSecurityPrincipal)
+	resourceData.Set("permissions", map[string]interface{}{"GENERIC_READ": permissionAllow, "DELETE": permissionDeny})
+	resourceData.Set("replace", false)
+
+	securityClient.
+		EXPECT().
+		SetAccessControlEntries(clients.Ctx, security.SetAccessControlEntriesArgs{
+			SecurityNamespaceId: &testSecurityNamespaceID,
+			Container: map[string]interface{}{
+				"token": testSecurityToken,
+				"merge": false,
+				"accessControlEntries": []security.AccessControlEntry{{
+					Descriptor: converter.String(testSecurityIdentity),
+					Allow:      converter.Int(9),
+					Deny:       converter.Int(4),
+				}},
+			},
+		}).
+		Return(nil, nil).
+		Times(1)
+
+	err := setSecurityPermissions(resourceData, clients, &testSecurityNamespaceID, testSecurityToken)
+	require.Nil(t, err)
+}
+
+// verifies that the entry of the principal is replaced when replace is set, and that inheritance is turned off
+func TestAzureDevOpsSecurityPermissions_Create_ReplacesPermissions(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	clients := newTestSecurityPermissionsClients(ctrl, &security.AccessControlEntry{
+		Descriptor: converter.String(testSecurityIdentity),
+		Allow:      converter.Int(8),
+	})
+	securityClient := clients.SecurityClient.(*azdosdkmocks.MockSecurityClient)
+
+	resourceData := schema.TestResourceDataRaw(t, resourceSecurityPermissions().Schema, nil)
+	resourceData.Set("namespace_id", testSecurityNamespaceID.String())
+	resourceData.Set("token", testSecurityToken)
+	resourceData.Set("principal", testSecurityPrincipal)
+	resourceData.Set("permissions", map[string]interface{}{"GENERIC_READ": permissionAllow})
+	resourceData.Set("inherit", false)
+
+	securityClient.
+		EXPECT().
+		SetAccessControlEntries(clients.Ctx, gomock.Any()).
+		DoAndReturn(func(ctx context.Context, args security.SetAccessControlEntriesArgs) (*[]security.AccessControlEntry, error) {
+			aces := args.Container.(map[string]interface{})["accessControlEntries"].([]security.AccessControlEntry)
+			require.Equal(t, 1, *aces[0].Allow)
+			require.Equal(t, 0, *aces[0].Deny)
+			return nil, nil
+		}).
+		Times(1)
+	securityClient.
+		EXPECT().
+		SetAccessControlLists(clients.Ctx, gomock.Any()).
+		DoAndReturn(func(ctx context.Context, args security.SetAccessControlListsArgs) error {
+			acl := (*args.AccessControlLists.Value)[0].(*security.AccessControlList)
+			require.False(t, *acl.InheritPermissions)
+			return nil
+		}).
+		Times(1)
+
+	err := setSecurityPermissions(resourceData, clients, &testSecurityNamespaceID, testSecurityToken)
+	require.Nil(t, err)
+}
+
+// verifies that the import ID is split into namespace, token and principal
+func TestAzureDevOpsSecurityPermissions_Import_ParsesID(t *testing.T) {
+	resourceData := schema.TestResourceDataRaw(t, resourceSecurityPermissions().Schema, nil)
+	resourceData.SetId(fmt.Sprintf("%s/repoV2/%s/%s", testSecurityNamespaceID.String(), "project/repository", testSecurityPrincipal))
+
+	_, err := resourceSecurityPermissions().Importer.State(resourceData, nil)
+	require.Nil(t, err)
+	require.Equal(t, testSecurityNamespaceID.String(), resourceData.Get("namespace_id"))
+	require.Equal(t, "repoV2/project/repository", resourceData.Get("token"))
+	require.Equal(t, testSecurityPrincipal, resourceData.Get("principal"))
+	require.Equal(t, fmt.Sprintf("%s/repoV2/%s/%s", testSecurityNamespaceID.String(), "project/repository", testSecurityPrincipal), resourceData.Id())
+
+	resourceData.SetId(testSecurityNamespaceID.String())
+	_, err = resourceSecurityPermissions().Importer.State(resourceData, nil)
+	require.NotNil(t, err)
+}
+
+// verifies that a token without access control lists is reported as having no access control list
+func TestAzureDevOpsSecurityPermissions_GetAccessControlList_HandlesNilResponse(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	securityClient := azdosdkmocks.NewMockSecurityClient(ctrl)
+	clients := &config.AggregatedClient{
+		SecurityClient: securityClient,
+		Ctx:            context.Background(),
+	}
+	securityClient.
+		EXPECT().
+		QueryAccessControlLists(clients.Ctx, gomock.Any()).
+		Return(nil, nil).
+		Times(1)
+
+	acl, err := getAccessControlList(clients, &testSecurityNamespaceID, testSecurityToken)
+	require.Nil(t, err)
+	require.Nil(t, acl)
+}
+
+// creates clients that resolve the test namespace and principal, and that return the given entry for the test token
+func newTestSecurityPermissionsClients(ctrl *gomock.Controller, ace *security.AccessControlEntry) *config.AggregatedClient {
+	securityClient := azdosdkmocks.NewMockSecurityClient(ctrl)
+	identityClient := azdosdkmocks.NewMockIdentityClient(ctrl)
+	clients := &config.AggregatedClient{
+		SecurityClient: securityClient,
+		IdentityClient: identityClient,
+		Ctx:            context.Background(),
+	}
+
+	actions := []security.ActionDefinition{}
+	for name, bit := range testSecurityActions {
+		actions = append(actions, security.ActionDefinition{Name: converter.String(name), Bit: converter.Int(bit)})
+	}
+	securityClient.
+		EXPECT().
+		QuerySecurityNamespaces(clients.Ctx, security.QuerySecurityNamespacesArgs{SecurityNamespaceId: &testSecurityNamespaceID}).
+		Return(&[]security.SecurityNamespaceDescription{{Actions: &actions}}, nil).
+		AnyTimes()
+	identityClient.
+		EXPECT().
+		ReadIdentities(clients.Ctx, identity.ReadIdentitiesArgs{SubjectDescriptors: converter.String(testSecurityPrincipal)}).
+		Return(&[]identity.Identity{{Descriptor: converter.String(testSecurityIdentity)}}, nil).
+		AnyTimes()
+	securityClient.
+		EXPECT().
+		QueryAccessControlLists(clients.Ctx, security.QueryAccessControlListsArgs{
+			SecurityNamespaceId: &testSecurityNamespaceID,
+			Token:               converter.String(testSecurityToken),
+		}).
+		Return(&[]security.AccessControlList{{
+			Token:              converter.String(testSecurityToken),
+			InheritPermissions: converter.Bool(true),
+			AcesDictionary:     &map[string]security.AccessControlEntry{testSecurityIdentity: *ace},
+		}}, nil).
+		AnyTimes()
+	return clients
+}
+
+/**
+ * Begin acceptance tests
+ */
+
+// Verifies that the permissions of a group on a project can be set and changed
+func TestAccAzureDevOpsSecurityPermissions_CreateAndUpdate(t *testing.T) {
+	projectName := testhelper.TestAccResourcePrefix + acctest.RandStringFromCharSet(10, acctest.CharSetAlphaNum)
+	tfNode := "azuredevops_security_permissions.permissions"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testhelper.TestAccPreCheck(t, nil) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccProjectCheckDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testhelper.TestAccSecurityPermissionsResource(projectName, permissionDeny),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(tfNode, "permissions.GENERIC_READ", permissionAllow),
+					resource.TestCheckResourceAttr(tfNode, "permissions.DELETE", permissionDeny),
+				),
+			},
+			{
+				Config: testhelper.TestAccSecurityPermissionsResource(projectName, permissionNotSet),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(tfNode, "permissions.DELETE", permissionNotSet),
+				),
+			},
+			{
+				ResourceName:      tfNode,
+				ImportStateIdFunc: testAccSecurityPermissionsImportStateIDFunc(tfNode),
+				ImportState:       true,
+				ImportStateVerify: true,
+				// permissions that are not set are not part of the imported state
+				ImportStateVerifyIgnore: []string{"permissions"},
+			},
+		},
+	})
+}
+
+func testAccSecurityPermissionsImportStateIDFunc(resourceName string) resource.ImportStateIdFunc {
+	return func(s *terraform.State) (string, error) {
+		return s.RootModule().Resources[resourceName].Primary.ID, nil
+	}
+}
+
+func init() {
+	InitProvider()
+}
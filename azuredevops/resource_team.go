@@ -0,0 +1,408 @@
+package azuredevops
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/google/uuid"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/microsoft/azure-devops-go-api/azuredevops/core"
+	"github.com/microsoft/azure-devops-go-api/azuredevops/graph"
+	"github.com/microsoft/azure-devops-go-api/azuredevops/identity"
+	"github.com/microsoft/azure-devops-go-api/azuredevops/security"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/utils/config"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/utils/converter"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/utils/validate"
+)
+
+// Team administrators are the identities that hold all permissions of the Identity security namespace
+// on the token of the team
+var identitySecurityNamespaceID = uuid.MustParse("5a27515b-ccd7-42c9-84f1-54c998f03866")
+
+// Read (1) | Write (2) | Delete (4) | ManageMembership (8) | CreateScope (16)
+const teamAdministratorPermissions = 31
+
+func resourceTeam() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceTeamCreate,
+		Read:   resourceTeamRead,
+		Update: resourceTeamUpdate,
+		Delete: resourceTeamDelete,
+		Importer: &schema.ResourceImporter{
+			State: importTeam,
+		},
+		Schema: map[string]*schema.Schema{
+			"project_id": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validate.UUID,
+			},
+			"name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validate.NoEmptyStrings,
+			},
+			"description": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Default:  "",
+			},
+			"members": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Computed: true,
+				Elem: &schema.Schema{
+					Type:         schema.TypeString,
+					ValidateFunc: validate.NoEmptyStrings,
+				},
+				Set: schema.HashString,
+			},
+			"administrators": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Computed: true,
+				Elem: &schema.Schema{
+					Type:         schema.TypeString,
+					ValidateFunc: validate.NoEmptyStrings,
+				},
+				Set: schema.HashString,
+			},
+			"descriptor": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func resourceTeamCreate(d *schema.ResourceData, m interface{}) error {
+	clients := m.(*config.AggregatedClient)
+	projectID := d.Get("project_id").(string)
+
+	team, err := clients.CoreClient.CreateTeam(clients.Ctx, core.CreateTeamArgs{
+		ProjectId: converter.String(projectID),
+		Team: &core.WebApiTeam{
+			Name:        converter.String(d.Get("name").(string)),
+			Description: converter.String(d.Get("description").(string)),
+		},
+	})
+	if err != nil {
+		return fmt.Errorf("Error creating team in Azure DevOps: %+v", err)
+	}
+	d.SetId(team.Id.String())
+
+	teamDescriptor, err := getTeamDescriptor(clients, team.Id)
+	if err != nil {
+		return err
+	}
+
+	if members, ok := d.GetOk("members"); ok {
+		memberships := expandGroupMembers(teamDescriptor, members.(*schema.Set))
+		if err := addMembers(clients, memberships); err != nil {
+			return fmt.Errorf("Error adding members to team %s: %+v", team.Id.String(), err)
+		}
+	}
+
+	if administrators, ok := d.GetOk("administrators"); ok {
+		if err := addTeamAdministrators(clients, projectID, team.Id.String(), administrators.(*schema.Set)); err != nil {
+			return fmt.Errorf("Error adding administrators to team %s: %+v", team.Id.String(), err)
+		}
+	}
+
+	return resourceTeamRead(d, m)
+}
+
+func resourceTeamRead(d *schema.ResourceData, m interface{}) error {
+	clients := m.(*config.AggregatedClient)
+	projectID := d.Get("project_id").(string)
+
+	team, err := clients.CoreClient.GetTeam(clients.Ctx, core.GetTeamArgs{
+		ProjectId: converter.String(projectID),
+		TeamId:    converter.String(d.Id()),
+	})
+	if err != nil {
+		return fmt.Errorf("Error reading team %s: %+v", d.Id(), err)
+	}
+
+	teamDescriptor, err := getTeamDescriptor(clients, team.Id)
+	if err != nil {
+		return err
+	}
+
+	memberships, err := getGroupMemberships(clients, teamDescriptor)
+	if err != nil {
+		return fmt.Errorf("Error reading members of team %s: %+v", d.Id(), err)
+	}
+	members, err := getGroupMembershipSet(memberships)
+	if err != nil {
+		return err
+	}
+
+	administrators, err := getTeamAdministrators(clients, projectID, team.Id.String())
+	if err != nil {
+		return fmt.Errorf("Error reading administrators of team %s: %+v", d.Id(), err)
+	}
+
+	d.SetId(team.Id.String())
+	d.Set("project_id", team.ProjectId.String())
+	d.Set("name", converter.ToString(team.Name, ""))
+	d.Set("description", converter.ToString(team.Description, ""))
+	d.Set("descriptor", teamDescriptor)
+	d.Set("members", members)
+	d.Set("administrators", administrators)
+	return nil
+}
+
+func resourceTeamUpdate(d *schema.ResourceData, m interface{}) error {
+	clients := m.(*config.AggregatedClient)
+	projectID := d.Get("project_id").(string)
+
+	if d.HasChange("name") || d.HasChange("description") {
+		_, err := clients.CoreClient.UpdateTeam(clients.Ctx, core.UpdateTeamArgs{
+			ProjectId: converter.String(projectID),
+			TeamId:    converter.String(d.Id()),
+			TeamData: &core.WebApiTeam{
+				Name:        converter.String(d.Get("name").(string)),
+				Description: converter.String(d.Get("description").(string)),
+			},
+		})
+		if err != nil {
+			return fmt.Errorf("Error updating team %s: %+v", d.Id(), err)
+		}
+	}
+
+	if d.HasChange("members") {
+		teamDescriptor := d.Get("descriptor").(string)
+		oldData, newData := d.GetChange("members")
+		// members that need to be added will be missing from the old data, but present in the new data
+		membersToAdd := newData.(*schema.Set).Difference(oldData.(*schema.Set))
+		// members that need to be removed will be missing from the new data, but present in the old data
+		membersToRemove := oldData.(*schema.Set).Difference(newData.(*schema.Set))
+
+		err := applyMembershipUpdate(clients,
+			expandGroupMembers(teamDescriptor, membersToAdd),
+			expandGroupMembers(teamDescriptor, membersToRemove))
+		if err != nil {
+			return fmt.Errorf("Error updating members of team %s: %+v", d.Id(), err)
+		}
+	}
+
+	if d.HasChange("administrators") {
+		oldData, newData := d.GetChange("administrators")
+		administratorsToAdd := newData.(*schema.Set).Difference(oldData.(*schema.Set))
+		administratorsToRemove := oldData.(*schema.Set).Difference(newData.(*schema.Set))
+
+		if err := removeTeamAdministrators(clients, projectID, d.Id(), administratorsToRemove); err != nil {
+			return fmt.Errorf("Error removing administrators from team %s: %+v", d.Id(), err)
+		}
+		if err := addTeamAdministrators(clients, projectID, d.Id(), administratorsToAdd); err != nil {
+			return fmt.Errorf("Error adding administrators to team %s: %+v", d.Id(), err)
+		}
+	}
+
+	return resourceTeamRead(d, m)
+}
+
+func resourceTeamDelete(d *schema.ResourceData, m interface{}) error {
+	clients := m.(*config.AggregatedClient)
+
+	err := clients.CoreClient.DeleteTeam(clients.Ctx, core.DeleteTeamArgs{
+		ProjectId: converter.String(d.Get("project_id").(string)),
+		TeamId:    converter.String(d.Id()),
+	})
+	if err != nil {
+		return fmt.Errorf("Error deleting team %s: %+v", d.Id(), err)
+	}
+
+	d.SetId("")
+	return nil
+}
+
+// Imports a team by its project and the name or ID of the team, e.g. <projectId>/<teamName>
+func importTeam(d *schema.ResourceData, m interface{}) ([]*schema.ResourceData, error) {
+	team, err := getImportedTeam(m.(*config.AggregatedClient), d.Id())
+	if err != nil {
+		return nil, err
+	}
+
+	d.SetId(team.Id.String())
+	d.Set("project_id", team.ProjectId.String())
+	return []*schema.ResourceData{d}, nil
+}
+
+// Looks up the team identified by an import ID of the form <projectId>/<teamId> or <projectId>/<teamName>
+func getImportedTeam(clients *config.AggregatedClient, id string) (*core.WebApiTeam, error) {
+	parts := strings.SplitN(id, "/", 2)
+	if len(parts) != 2 || parts[0] == "" || parts[1] == "" {
+		return nil, fmt.Errorf("Unexpected format of ID (%s), expected projectid/teamId or projectid/teamName", id)
+	}
+
+	team, err := clients.CoreClient.GetTeam(clients.Ctx, core.GetTeamArgs{
+		ProjectId: converter.String(parts[0]),
+		TeamId:    converter.String(parts[1]),
+	})
+	if err != nil {
+		return nil, fmt.Errorf("Error reading team %s: %+v", id, err)
+	}
+	return team, nil
+}
+
+// Looks up the graph descriptor of a team. The descriptor is used to manage the members of the team.
+func getTeamDescriptor(clients *config.AggregatedClient, teamID *uuid.UUID) (string, error) {
+	descriptor, err := clients.GraphClient.GetDescriptor(clients.Ctx, graph.GetDescriptorArgs{StorageKey: teamID})
+	if err != nil {
+		return "", fmt.Errorf("Error finding descriptor for team with ID %s. Error: %v", teamID.String(), err)
+	}
+	return *descriptor.Value, nil
+}
+
+func getTeamSecurityToken(projectID string, teamID string) string {
+	return fmt.Sprintf("%s\\%s", projectID, teamID)
+}
+
+// Reads the subject descriptors of all identities that are allowed to administer a team
+func getTeamAdministrators(clients *config.AggregatedClient, projectID string, teamID string) (*schema.Set, error) {
+	acls, err := clients.SecurityClient.QueryAccessControlLists(clients.Ctx, security.QueryAccessControlListsArgs{
+		SecurityNamespaceId: &identitySecurityNamespaceID,
+		Token:               converter.String(getTeamSecurityToken(projectID, teamID)),
+	})
+	if err != nil {
+		return nil, err
+	}
+	// the team does not have an access control list if no identity was granted permissions on it
+	if acls == nil {
+		return schema.NewSet(schema.HashString, nil), nil
+	}
+
+	var identityDescriptors []string
+	for _, acl := range *acls {
+		if acl.AcesDictionary == nil {
+			continue
+		}
+		for _, ace := range *acl.AcesDictionary {
+			if ace.Allow != nil && *ace.Allow&teamAdministratorPermissions == teamAdministratorPermissions && ace.Descriptor != nil {
+				identityDescriptors = append(identityDescriptors, *ace.Descriptor)
+			}
+		}
+	}
+
+	subjectDescriptors, err := getSubjectDescriptors(clients, identityDescriptors)
+	if err != nil {
+		return nil, err
+	}
+
+	administrators := schema.NewSet(schema.HashString, nil)
+	for _, descriptor := range subjectDescriptors {
+		administrators.Add(descriptor)
+	}
+	return administrators, nil
+}
+
+func addTeamAdministrators(clients *config.AggregatedClient, projectID string, teamID string, administrators *schema.Set) error {
+	if administrators == nil || administrators.Len() == 0 {
+		return nil
+	}
+
+	identityDescriptors, err := getIdentityDescriptors(clients, expandStringSet(administrators))
+	if err != nil {
+		return err
+	}
+
+	aces := make([]security.AccessControlEntry, len(identityDescriptors))
+	for i, descriptor := range identityDescriptors {
+		aces[i] = security.AccessControlEntry{
+			Descriptor: converter.String(descriptor),
+			Allow:      converter.Int(teamAdministratorPermissions),
+			Deny:       converter.Int(0),
+		}
+	}
+
+	_, err = clients.SecurityClient.SetAccessControlEntries(clients.Ctx, security.SetAccessControlEntriesArgs{
+		SecurityNamespaceId: &identitySecurityNamespaceID,
+		Container: map[string]interface{}{
+			"token":                getTeamSecurityToken(projectID, teamID),
+			"merge":                true,
+			"accessControlEntries": aces,
+		},
+	})
+	return err
+}
+
+func removeTeamAdministrators(clients *config.AggregatedClient, projectID string, teamID string, administrators *schema.Set) error {
+	if administrators == nil || administrators.Len() == 0 {
+		return nil
+	}
+
+	identityDescriptors, err := getIdentityDescriptors(clients, expandStringSet(administrators))
+	if err != nil {
+		return err
+	}
+
+	_, err = clients.SecurityClient.RemoveAccessControlEntries(clients.Ctx, security.RemoveAccessControlEntriesArgs{
+		SecurityNamespaceId: &identitySecurityNamespaceID,
+		Token:               converter.String(getTeamSecurityToken(projectID, teamID)),
+		Descriptors:         converter.String(strings.Join(identityDescriptors, ",")),
+	})
+	return err
+}
+
+// Resolves graph subject descriptors (e.g. aad.xxx, vssgp.xxx) into the identity descriptors used by the security APIs
+func getIdentityDescriptors(clients *config.AggregatedClient, subjectDescriptors []string) ([]string, error) {
+	if len(subjectDescriptors) == 0 {
+		return []string{}, nil
+	}
+
+	identities, err := clients.IdentityClient.ReadIdentities(clients.Ctx, identity.ReadIdentitiesArgs{
+		SubjectDescriptors: converter.String(strings.Join(subjectDescriptors, ",")),
+	})
+	if err != nil {
+		return nil, err
+	}
+	if identities == nil || len(*identities) != len(subjectDescriptors) {
+		return nil, fmt.Errorf("Could not resolve identities of subjects %s", strings.Join(subjectDescriptors, ","))
+	}
+
+	descriptors := make([]string, 0, len(subjectDescriptors))
+	for i, resolvedIdentity := range *identities {
+		if resolvedIdentity.Descriptor == nil {
+			return nil, fmt.Errorf("Could not resolve identity of subject %s", subjectDescriptors[i])
+		}
+		descriptors = append(descriptors, *resolvedIdentity.Descriptor)
+	}
+	return descriptors, nil
+}
+
+// Resolves identity descriptors used by the security APIs into graph subject descriptors
+func getSubjectDescriptors(clients *config.AggregatedClient, identityDescriptors []string) ([]string, error) {
+	if len(identityDescriptors) == 0 {
+		return []string{}, nil
+	}
+
+	identities, err := clients.IdentityClient.ReadIdentities(clients.Ctx, identity.ReadIdentitiesArgs{
+		Descriptors: converter.String(strings.Join(identityDescriptors, ",")),
+	})
+	if err != nil {
+		return nil, err
+	}
+	if identities == nil {
+		return nil, fmt.Errorf("Could not resolve subjects of identities %s", strings.Join(identityDescriptors, ","))
+	}
+
+	descriptors := make([]string, 0, len(identityDescriptors))
+	for _, resolvedIdentity := range *identities {
+		// identities that no longer exist are returned as null entries
+		if resolvedIdentity.SubjectDescriptor != nil {
+			descriptors = append(descriptors, *resolvedIdentity.SubjectDescriptor)
+		}
+	}
+	return descriptors, nil
+}
+
+func expandStringSet(set *schema.Set) []string {
+	values := make([]string, 0, set.Len())
+	for _, value := range set.List() {
+		values = append(values, value.(string))
+	}
+	return values
+}
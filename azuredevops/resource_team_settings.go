@@ -0,0 +1,412 @@
+package azuredevops
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/google/uuid"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/validation"
+	"github.com/microsoft/azure-devops-go-api/azuredevops/work"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/utils/config"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/utils/converter"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/utils/validate"
+)
+
+// All settings are optional and computed. Only the settings that are configured are written to
+// Azure DevOps, so that settings which are maintained manually do not cause a diff.
+func resourceTeamSettings() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceTeamSettingsCreate,
+		Read:   resourceTeamSettingsRead,
+		Update: resourceTeamSettingsUpdate,
+		Delete: resourceTeamSettingsDelete,
+		Importer: &schema.ResourceImporter{
+			State: importTeamSettings,
+		},
+		Schema: map[string]*schema.Schema{
+			"project_id": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validate.UUID,
+			},
+			"team_id": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validate.UUID,
+			},
+			"default_area_path": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validate.NoEmptyStrings,
+			},
+			"area_path": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"path": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validate.NoEmptyStrings,
+						},
+						"include_children": {
+							Type:     schema.TypeBool,
+							Optional: true,
+							Default:  false,
+						},
+					},
+				},
+			},
+			"backlog_iteration_id": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validate.UUID,
+			},
+			"default_iteration_id": {
+				Type:          schema.TypeString,
+				Optional:      true,
+				Computed:      true,
+				ValidateFunc:  validate.UUID,
+				ConflictsWith: []string{"default_iteration_macro"},
+			},
+			"default_iteration_macro": {
+				Type:          schema.TypeString,
+				Optional:      true,
+				Computed:      true,
+				ValidateFunc:  validate.NoEmptyStrings,
+				ConflictsWith: []string{"default_iteration_id"},
+			},
+			"iteration_ids": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Computed: true,
+				Elem: &schema.Schema{
+					Type:         schema.TypeString,
+					ValidateFunc: validate.UUID,
+				},
+				Set: schema.HashString,
+			},
+			"working_days": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Computed: true,
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+					ValidateFunc: validation.StringInSlice([]string{
+						"sunday", "monday", "tuesday", "wednesday", "thursday", "friday", "saturday",
+					}, false),
+				},
+				Set: schema.HashString,
+			},
+			"bugs_behavior": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ValidateFunc: validation.StringInSlice([]string{
+					string(work.BugsBehaviorValues.Off),
+					string(work.BugsBehaviorValues.AsRequirements),
+					string(work.BugsBehaviorValues.AsTasks),
+				}, false),
+			},
+			"backlog_visibilities": {
+				Type:     schema.TypeMap,
+				Optional: true,
+				Computed: true,
+				Elem: &schema.Schema{
+					Type: schema.TypeBool,
+				},
+			},
+		},
+	}
+}
+
+func resourceTeamSettingsCreate(d *schema.ResourceData, m interface{}) error {
+	d.SetId(d.Get("team_id").(string))
+	if err := applyTeamSettings(d, m.(*config.AggregatedClient)); err != nil {
+		d.SetId("")
+		return err
+	}
+	return resourceTeamSettingsRead(d, m)
+}
+
+func resourceTeamSettingsRead(d *schema.ResourceData, m interface{}) error {
+	clients := m.(*config.AggregatedClient)
+	project, team := converter.String(d.Get("project_id").(string)), converter.String(d.Id())
+
+	settings, err := clients.WorkClient.GetTeamSettings(clients.Ctx, work.GetTeamSettingsArgs{
+		Project: project,
+		Team:    team,
+	})
+	if err != nil {
+		return fmt.Errorf("Error reading settings of team %s: %+v", d.Id(), err)
+	}
+
+	fieldValues, err := clients.WorkClient.GetTeamFieldValues(clients.Ctx, work.GetTeamFieldValuesArgs{
+		Project: project,
+		Team:    team,
+	})
+	if err != nil {
+		return fmt.Errorf("Error reading area paths of team %s: %+v", d.Id(), err)
+	}
+
+	iterations, err := clients.WorkClient.GetTeamIterations(clients.Ctx, work.GetTeamIterationsArgs{
+		Project: project,
+		Team:    team,
+	})
+	if err != nil {
+		return fmt.Errorf("Error reading iterations of team %s: %+v", d.Id(), err)
+	}
+
+	d.Set("team_id", d.Id())
+	flattenTeamSettings(d, settings)
+	flattenTeamFieldValues(d, fieldValues)
+	d.Set("iteration_ids", flattenTeamIterationIDs(iterations))
+	return nil
+}
+
+func resourceTeamSettingsUpdate(d *schema.ResourceData, m interface{}) error {
+	if err := applyTeamSettings(d, m.(*config.AggregatedClient)); err != nil {
+		return err
+	}
+	return resourceTeamSettingsRead(d, m)
+}
+
+// Team settings always exist for a team, so they cannot be deleted. Removing the resource
+// leaves the settings of the team unchanged.
+func resourceTeamSettingsDelete(d *schema.ResourceData, m interface{}) error {
+	d.SetId("")
+	return nil
+}
+
+// Imports the settings of a team by its project and the name or ID of the team, e.g. <projectId>/<teamName>
+func importTeamSettings(d *schema.ResourceData, m interface{}) ([]*schema.ResourceData, error) {
+	team, err := getImportedTeam(m.(*config.AggregatedClient), d.Id())
+	if err != nil {
+		return nil, err
+	}
+
+	d.SetId(team.Id.String())
+	d.Set("project_id", team.ProjectId.String())
+	d.Set("team_id", team.Id.String())
+	return []*schema.ResourceData{d}, nil
+}
+
+// Writes each group of changed settings through its own endpoint
+func applyTeamSettings(d *schema.ResourceData, clients *config.AggregatedClient) error {
+	project, team := converter.String(d.Get("project_id").(string)), converter.String(d.Id())
+
+	if patch := expandTeamSettingsPatch(d); patch != nil {
+		_, err := clients.WorkClient.UpdateTeamSettings(clients.Ctx, work.UpdateTeamSettingsArgs{
+			Project:           project,
+			Team:              team,
+			TeamSettingsPatch: patch,
+		})
+		if err != nil {
+			return fmt.Errorf("Error updating settings of team %s: %+v", d.Id(), err)
+		}
+	}
+
+	if d.HasChange("default_area_path") || d.HasChange("area_path") {
+		if err := updateTeamFieldValues(d, clients); err != nil {
+			return fmt.Errorf("Error updating area paths of team %s: %+v", d.Id(), err)
+		}
+	}
+
+	if d.HasChange("iteration_ids") {
+		if err := updateTeamIterations(d, clients); err != nil {
+			return fmt.Errorf("Error updating iterations of team %s: %+v", d.Id(), err)
+		}
+	}
+
+	return nil
+}
+
+// Builds a patch that only contains the changed settings, or nil if no setting has changed
+func expandTeamSettingsPatch(d *schema.ResourceData) *work.TeamSettingsPatch {
+	patch := work.TeamSettingsPatch{}
+	hasChanges := false
+
+	if d.HasChange("backlog_iteration_id") {
+		if id, err := uuid.Parse(d.Get("backlog_iteration_id").(string)); err == nil {
+			patch.BacklogIteration = &id
+			hasChanges = true
+		}
+	}
+	if d.HasChange("default_iteration_id") {
+		if id, err := uuid.Parse(d.Get("default_iteration_id").(string)); err == nil {
+			patch.DefaultIteration = &id
+			hasChanges = true
+		}
+	}
+	if d.HasChange("default_iteration_macro") && d.Get("default_iteration_macro").(string) != "" {
+		patch.DefaultIterationMacro = converter.String(d.Get("default_iteration_macro").(string))
+		hasChanges = true
+	}
+	if d.HasChange("working_days") {
+		workingDays := expandStringSet(d.Get("working_days").(*schema.Set))
+		patch.WorkingDays = &workingDays
+		hasChanges = true
+	}
+	if d.HasChange("bugs_behavior") && d.Get("bugs_behavior").(string) != "" {
+		bugsBehavior := work.BugsBehavior(d.Get("bugs_behavior").(string))
+		patch.BugsBehavior = &bugsBehavior
+		hasChanges = true
+	}
+	if d.HasChange("backlog_visibilities") {
+		visibilities := map[string]bool{}
+		for category, visible := range d.Get("backlog_visibilities").(map[string]interface{}) {
+			visibilities[category] = visible.(bool)
+		}
+		patch.BacklogVisibilities = &visibilities
+		hasChanges = true
+	}
+
+	if !hasChanges {
+		return nil
+	}
+	return &patch
+}
+
+// The team field values endpoint requires the default value and all values to be sent together.
+// Whichever of the two is not configured is taken from the current settings of the team.
+func updateTeamFieldValues(d *schema.ResourceData, clients *config.AggregatedClient) error {
+	project, team := converter.String(d.Get("project_id").(string)), converter.String(d.Id())
+
+	current, err := clients.WorkClient.GetTeamFieldValues(clients.Ctx, work.GetTeamFieldValuesArgs{
+		Project: project,
+		Team:    team,
+	})
+	if err != nil {
+		return err
+	}
+
+	patch := work.TeamFieldValuesPatch{
+		DefaultValue: current.DefaultValue,
+		Values:       current.Values,
+	}
+	if defaultValue := d.Get("default_area_path").(string); defaultValue != "" {
+		patch.DefaultValue = converter.String(defaultValue)
+	}
+	if areaPaths := d.Get("area_path").(*schema.Set); areaPaths.Len() > 0 {
+		patch.Values = expandTeamFieldValues(areaPaths)
+	}
+
+	_, err = clients.WorkClient.UpdateTeamFieldValues(clients.Ctx, work.UpdateTeamFieldValuesArgs{
+		Project: project,
+		Team:    team,
+		Patch:   &patch,
+	})
+	return err
+}
+
+// Subscribes and unsubscribes the team to iterations so that the team ends up with exactly the configured iterations
+func updateTeamIterations(d *schema.ResourceData, clients *config.AggregatedClient) error {
+	project, team := converter.String(d.Get("project_id").(string)), converter.String(d.Id())
+
+	current, err := clients.WorkClient.GetTeamIterations(clients.Ctx, work.GetTeamIterationsArgs{
+		Project: project,
+		Team:    team,
+	})
+	if err != nil {
+		return err
+	}
+
+	currentIDs := flattenTeamIterationIDs(current)
+	desiredIDs := d.Get("iteration_ids").(*schema.Set)
+
+	for _, id := range currentIDs.Difference(desiredIDs).List() {
+		iterationID := uuid.MustParse(id.(string))
+		err := clients.WorkClient.DeleteTeamIteration(clients.Ctx, work.DeleteTeamIterationArgs{
+			Project: project,
+			Team:    team,
+			Id:      &iterationID,
+		})
+		if err != nil {
+			return err
+		}
+	}
+
+	for _, id := range desiredIDs.Difference(currentIDs).List() {
+		iterationID := uuid.MustParse(id.(string))
+		_, err := clients.WorkClient.PostTeamIteration(clients.Ctx, work.PostTeamIterationArgs{
+			Project:   project,
+			Team:      team,
+			Iteration: &work.TeamSettingsIteration{Id: &iterationID},
+		})
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func expandTeamFieldValues(areaPaths *schema.Set) *[]work.TeamFieldValue {
+	values := make([]work.TeamFieldValue, 0, areaPaths.Len())
+	for _, item := range areaPaths.List() {
+		areaPath := item.(map[string]interface{})
+		values = append(values, work.TeamFieldValue{
+			Value:           converter.String(areaPath["path"].(string)),
+			IncludeChildren: converter.Bool(areaPath["include_children"].(bool)),
+		})
+	}
+	return &values
+}
+
+func flattenTeamSettings(d *schema.ResourceData, settings *work.TeamSetting) {
+	if settings.BacklogIteration != nil && settings.BacklogIteration.Id != nil {
+		d.Set("backlog_iteration_id", settings.BacklogIteration.Id.String())
+	}
+	if settings.DefaultIteration != nil && settings.DefaultIteration.Id != nil {
+		d.Set("default_iteration_id", settings.DefaultIteration.Id.String())
+	}
+	d.Set("default_iteration_macro", converter.ToString(settings.DefaultIterationMacro, ""))
+	if settings.WorkingDays != nil {
+		workingDays := make([]string, 0, len(*settings.WorkingDays))
+		for _, day := range *settings.WorkingDays {
+			workingDays = append(workingDays, strings.ToLower(day))
+		}
+		d.Set("working_days", workingDays)
+	}
+	if settings.BugsBehavior != nil {
+		d.Set("bugs_behavior", string(*settings.BugsBehavior))
+	}
+	if settings.BacklogVisibilities != nil {
+		d.Set("backlog_visibilities", *settings.BacklogVisibilities)
+	}
+}
+
+func flattenTeamFieldValues(d *schema.ResourceData, fieldValues *work.TeamFieldValues) {
+	d.Set("default_area_path", converter.ToString(fieldValues.DefaultValue, ""))
+	if fieldValues.Values == nil {
+		return
+	}
+
+	areaPaths := make([]interface{}, 0, len(*fieldValues.Values))
+	for _, value := range *fieldValues.Values {
+		areaPaths = append(areaPaths, map[string]interface{}{
+			"path":             converter.ToString(value.Value, ""),
+			"include_children": converter.ToBool(value.IncludeChildren, false),
+		})
+	}
+	d.Set("area_path", areaPaths)
+}
+
+func flattenTeamIterationIDs(iterations *[]work.TeamSettingsIteration) *schema.Set {
+	ids := schema.NewSet(schema.HashString, nil)
+	if iterations == nil {
+		return ids
+	}
+	for _, iteration := range *iterations {
+		if iteration.Id != nil {
+			ids.Add(iteration.Id.String())
+		}
+	}
+	return ids
+}
@@ -0,0 +1,213 @@
+// +build all work resource_team_settings
+
+package azuredevops
+
+// The tests in this file use the mock clients in mock_client.go to mock out
+// the Azure DevOps client operations.
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/google/uuid"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/microsoft/azure-devops-go-api/azuredevops/work"
+	"github.com/microsoft/terraform-provider-azuredevops/azdosdkmocks"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/utils/config"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/utils/converter"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/utils/testhelper"
+	"github.com/stretchr/testify/require"
+)
+
+var testTeamSettingsProjectID = uuid.New()
+var testTeamSettingsTeamID = uuid.New()
+
+func getTeamSettingsResourceData(t *testing.T, raw map[string]interface{}) *schema.ResourceData {
+	raw["project_id"] = testTeamSettingsProjectID.String()
+	raw["team_id"] = testTeamSettingsTeamID.String()
+	return schema.TestResourceDataRaw(t, resourceTeamSettings().Schema, raw)
+}
+
+/**
+ * Begin unit tests
+ */
+
+// verifies that only the configured settings are sent to the service
+func TestAzureDevOpsTeamSettings_ExpandPatch_OnlyContainsConfiguredSettings(t *testing.T) {
+	resourceData := getTeamSettingsResourceData(t, map[string]interface{}{
+		"bugs_behavior": "asTasks",
+	})
+
+	patch := expandTeamSettingsPatch(resourceData)
+	require.NotNil(t, patch)
+	require.Equal(t, work.BugsBehaviorValues.AsTasks, *patch.BugsBehavior)
+	require.Nil(t, patch.WorkingDays)
+	require.Nil(t, patch.BacklogIteration)
+	require.Nil(t, patch.BacklogVisibilities)
+
+	require.Nil(t, expandTeamSettingsPatch(getTeamSettingsResourceData(t, map[string]interface{}{})))
+}
+
+// verifies that the create operation does not swallow errors of the team settings endpoint
+func TestAzureDevOpsTeamSettings_Create_DoesNotSwallowError(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	workClient := azdosdkmocks.NewMockWorkClient(ctrl)
+	clients := &config.AggregatedClient{
+		WorkClient: workClient,
+		Ctx:        context.Background(),
+	}
+
+	resourceData := getTeamSettingsResourceData(t, map[string]interface{}{
+		"working_days": []interface{}{"monday"},
+	})
+
+	workClient.
+		EXPECT().
+		UpdateTeamSettings(clients.Ctx, work.UpdateTeamSettingsArgs{
+			Project: converter.String(testTeamSettingsProjectID.String()),
+			Team:    converter.String(testTeamSettingsTeamID.String()),
+			TeamSettingsPatch: &work.TeamSettingsPatch{
+				WorkingDays: &[]string{"monday"},
+			},
+		}).
+		Return(nil, errors.New("UpdateTeamSettings() Failed")).
+		Times(1)
+
+	err := resourceTeamSettingsCreate(resourceData, clients)
+	require.Contains(t, err.Error(), "UpdateTeamSettings() Failed")
+	require.Equal(t, "", resourceData.Id())
+}
+
+// verifies that the configured area paths are combined with the current default area path
+func TestAzureDevOpsTeamSettings_UpdateFieldValues_KeepsCurrentDefault(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	workClient := azdosdkmocks.NewMockWorkClient(ctrl)
+	clients := &config.AggregatedClient{
+		WorkClient: workClient,
+		Ctx:        context.Background(),
+	}
+
+	resourceData := getTeamSettingsResourceData(t, map[string]interface{}{
+		"area_path": []interface{}{
+			map[string]interface{}{"path": "Project\\Platform", "include_children": true},
+		},
+	})
+	resourceData.SetId(testTeamSettingsTeamID.String())
+
+	workClient.
+		EXPECT().
+		GetTeamFieldValues(clients.Ctx, gomock.Any()).
+		Return(&work.TeamFieldValues{
+			DefaultValue: converter.String("Project\\Platform"),
+			Values:       &[]work.TeamFieldValue{{Value: converter.String("Project")}},
+		}, nil).
+		Times(1)
+	workClient.
+		EXPECT().
+		UpdateTeamFieldValues(clients.Ctx, work.UpdateTeamFieldValuesArgs{
+			Project: converter.String(testTeamSettingsProjectID.String()),
+			Team:    converter.String(testTeamSettingsTeamID.String()),
+			Patch: &work.TeamFieldValuesPatch{
+				DefaultValue: converter.String("Project\\Platform"),
+				Values: &[]work.TeamFieldValue{
+					{Value: converter.String("Project\\Platform"), IncludeChildren: converter.Bool(true)},
+				},
+			},
+		}).
+		Return(nil, nil).
+		Times(1)
+
+	err := updateTeamFieldValues(resourceData, clients)
+	require.Nil(t, err)
+}
+
+// verifies that the team is subscribed to exactly the configured iterations
+func TestAzureDevOpsTeamSettings_UpdateIterations_AddsAndRemoves(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	workClient := azdosdkmocks.NewMockWorkClient(ctrl)
+	clients := &config.AggregatedClient{
+		WorkClient: workClient,
+		Ctx:        context.Background(),
+	}
+
+	keptIteration, removedIteration, addedIteration := uuid.New(), uuid.New(), uuid.New()
+	resourceData := getTeamSettingsResourceData(t, map[string]interface{}{
+		"iteration_ids": []interface{}{keptIteration.String(), addedIteration.String()},
+	})
+	resourceData.SetId(testTeamSettingsTeamID.String())
+
+	workClient.
+		EXPECT().
+		GetTeamIterations(clients.Ctx, gomock.Any()).
+		Return(&[]work.TeamSettingsIteration{{Id: &keptIteration}, {Id: &removedIteration}}, nil).
+		Times(1)
+	workClient.
+		EXPECT().
+		DeleteTeamIteration(clients.Ctx, work.DeleteTeamIterationArgs{
+			Project: converter.String(testTeamSettingsProjectID.String()),
+			Team:    converter.String(testTeamSettingsTeamID.String()),
+			Id:      &removedIteration,
+		}).
+		Return(nil).
+		Times(1)
+	workClient.
+		EXPECT().
+		PostTeamIteration(clients.Ctx, work.PostTeamIterationArgs{
+			Project:   converter.String(testTeamSettingsProjectID.String()),
+			Team:      converter.String(testTeamSettingsTeamID.String()),
+			Iteration: &work.TeamSettingsIteration{Id: &addedIteration},
+		}).
+		Return(nil, nil).
+		Times(1)
+
+	err := updateTeamIterations(resourceData, clients)
+	require.Nil(t, err)
+}
+
+/**
+ * Begin acceptance tests
+ */
+
+// Verifies that the settings of a team can be configured and updated
+func TestAccAzureDevOpsTeamSettings_CreateAndUpdate(t *testing.T) {
+	projectName := testhelper.TestAccResourcePrefix + acctest.RandStringFromCharSet(10, acctest.CharSetAlphaNum)
+	teamName := testhelper.TestAccResourcePrefix + acctest.RandStringFromCharSet(10, acctest.CharSetAlphaNum)
+	tfNode := "azuredevops_team_settings.settings"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testhelper.TestAccPreCheck(t, nil) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccTeamCheckDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testhelper.TestAccTeamSettingsResource(projectName, teamName, "asTasks"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(tfNode, "bugs_behavior", "asTasks"),
+					resource.TestCheckResourceAttr(tfNode, "working_days.#", "4"),
+					resource.TestCheckResourceAttrSet(tfNode, "default_area_path"),
+					resource.TestCheckResourceAttrSet(tfNode, "backlog_iteration_id"),
+				),
+			},
+			{
+				Config: testhelper.TestAccTeamSettingsResource(projectName, teamName, "off"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(tfNode, "bugs_behavior", "off"),
+				),
+			},
+		},
+	})
+}
+
+func init() {
+	InitProvider()
+}
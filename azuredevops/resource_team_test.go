@@ -0,0 +1,390 @@
+// +build all core resource_team
+
+package azuredevops
+
+// The tests in this file use the mock clients in mock_client.go to mock out
+// the Azure DevOps client operations.
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/google/uuid"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/terraform"
+	"github.com/microsoft/azure-devops-go-api/azuredevops/core"
+	"github.com/microsoft/azure-devops-go-api/azuredevops/graph"
+	"github.com/microsoft/azure-devops-go-api/azuredevops/identity"
+	"github.com/microsoft/azure-devops-go-api/azuredevops/security"
+	"github.com/microsoft/terraform-provider-azuredevops/azdosdkmocks"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/utils/config"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/utils/converter"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/utils/testhelper"
+	"github.com/stretchr/testify/require"
+)
+
+var testTeamProjectID = uuid.New()
+var testTeamID = uuid.New()
+
+var testTeam = core.WebApiTeam{
+	Id:          &testTeamID,
+	ProjectId:   &testTeamProjectID,
+	Name:        converter.String("Platform"),
+	Description: converter.String("The platform team"),
+}
+
+/**
+ * Begin unit tests
+ */
+
+// verifies that the create operation is considered failed if the API call fails.
+func TestAzureDevOpsTeam_Create_DoesNotSwallowError(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	coreClient := azdosdkmocks.NewMockCoreClient(ctrl)
+	clients := &config.AggregatedClient{
+		CoreClient: coreClient,
+		Ctx:        context.Background(),
+	}
+
+	resourceData := schema.TestResourceDataRaw(t, resourceTeam().Schema, nil)
+	resourceData.Set("project_id", testTeamProjectID.String())
+	resourceData.Set("name", *testTeam.Name)
+	resourceData.Set("description", *testTeam.Description)
+
+	coreClient.
+		EXPECT().
+		CreateTeam(clients.Ctx, core.CreateTeamArgs{
+			ProjectId: converter.String(testTeamProjectID.String()),
+			Team: &core.WebApiTeam{
+				Name:        testTeam.Name,
+				Description: testTeam.Description,
+			},
+		}).
+		Return(nil, errors.New("CreateTeam() Failed")).
+		Times(1)
+
+	err := resourceTeamCreate(resourceData, clients)
+	require.Contains(t, err.Error(), "CreateTeam() Failed")
+}
+
+// verifies that the read operation resolves the members and administrators of the team
+func TestAzureDevOpsTeam_Read_ReadsMembersAndAdministrators(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	coreClient := azdosdkmocks.NewMockCoreClient(ctrl)
+	graphClient := azdosdkmocks.NewMockGraphClient(ctrl)
+	securityClient := azdosdkmocks.NewMockSecurityClient(ctrl)
+	identityClient := azdosdkmocks.NewMockIdentityClient(ctrl)
+	clients := &config.AggregatedClient{
+		CoreClient:     coreClient,
+		GraphClient:    graphClient,
+		SecurityClient: securityClient,
+		IdentityClient: identityClient,
+		Ctx:            context.Background(),
+	}
+
+	resourceData := schema.TestResourceDataRaw(t, resourceTeam().Schema, nil)
+	resourceData.Set("project_id", testTeamProjectID.String())
+	resourceData.SetId(testTeamID.String())
+
+	coreClient.
+		EXPECT().
+		GetTeam(clients.Ctx, core.GetTeamArgs{
+			ProjectId: converter.String(testTeamProjectID.String()),
+			TeamId:    converter.String(testTeamID.String()),
+		}).
+		Return(&testTeam, nil).
+		Times(1)
+	graphClient.
+		EXPECT().
+		GetDescriptor(clients.Ctx, graph.GetDescriptorArgs{StorageKey: &testTeamID}).
+		Return(&graph.GraphDescriptorResult{Value: converter.String("vssgp.team")}, nil).
+		Times(1)
+	graphClient.
+		EXPECT().
+		ListMemberships(clients.Ctx, gomock.Any()).
+		Return(&[]graph.GraphMembership{
+			{ContainerDescriptor: converter.String("vssgp.team"), MemberDescriptor: converter.String("aad.member")},
+		}, nil).
+		Times(1)
+	securityClient.
+		EXPECT().
+		QueryAccessControlLists(clients.Ctx, security.QueryAccessControlListsArgs{
+			SecurityNamespaceId: &identitySecurityNamespaceID,
+			Token:               converter.String(testTeamProjectID.String() + "\\" + testTeamID.String()),
+		}).
+		Return(&[]security.AccessControlList{
+			{
+				AcesDictionary: &map[string]security.AccessControlEntry{
+					"Microsoft.TeamFoundation.Identity;S-1-admin": {
+						Descriptor: converter.String("Microsoft.TeamFoundation.Identity;S-1-admin"),
+						Allow:      converter.Int(31),
+					},
+					"Microsoft.TeamFoundation.Identity;S-1-reader": {
+						Descriptor: converter.String("Microsoft.TeamFoundation.Identity;S-1-reader"),
+						Allow:      converter.Int(1),
+					},
+				},
+			},
+		}, nil).
+		Times(1)
+	identityClient.
+		EXPECT().
+		ReadIdentities(clients.Ctx, identity.ReadIdentitiesArgs{
+			Descriptors: converter.String("Microsoft.TeamFoundation.Identity;S-1-admin"),
+		}).
+		Return(&[]identity.Identity{
+			{SubjectDescriptor: converter.String("aad.admin")},
+		}, nil).
+		Times(1)
+
+	err := resourceTeamRead(resourceData, clients)
+	require.Nil(t, err)
+	require.Equal(t, "Platform", resourceData.Get("name"))
+	require.Equal(t, "vssgp.team", resourceData.Get("descriptor"))
+	require.Equal(t, []interface{}{"aad.member"}, resourceData.Get("members").(*schema.Set).List())
+	require.Equal(t, []interface{}{"aad.admin"}, resourceData.Get("administrators").(*schema.Set).List())
+}
+
+// verifies that administrators are granted all permissions of the team security token
+func TestAzureDevOpsTeam_AddAdministrators_GrantsPermissions(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	securityClient := azdosdkmocks.NewMockSecurityClient(ctrl)
+	identityClient := azdosdkmocks.NewMockIdentityClient(ctrl)
+	clients := &config.AggregatedClient{
+		SecurityClient: securityClient,
+		IdentityClient: identityClient,
+		Ctx:            context.Background(),
+	}
+
+	identityClient.
+		EXPECT().
+		ReadIdentities(clients.Ctx, identity.ReadIdentitiesArgs{
+			SubjectDescriptors: converter.String("aad.admin"),
+		}).
+		Return(&[]identity.Identity{
+			{Descriptor: converter.String("Microsoft.TeamFoundation.Identity;S-1-admin")},
+		}, nil).
+		Times(1)
+	securityClient.
+		EXPECT().
+		SetAccessControlEntries(clients.Ctx, security.SetAccessControlEntriesArgs{
+			SecurityNamespaceId: &identitySecurityNamespaceID,
+			Container: map[string]interface{}{
+				"token": testTeamProjectID.String() + "\\" + testTeamID.String(),
+				"merge": true,
+				"accessControlEntries": []security.AccessControlEntry{
+					{
+						Descriptor: converter.String("Microsoft.TeamFoundation.Identity;S-1-admin"),
+						Allow:      converter.Int(31),
+						Deny:       converter.Int(0),
+					},
+				},
+			},
+		}).
+		Return(nil, errors.New("SetAccessControlEntries() Failed")).
+		Times(1)
+
+	administrators := schema.NewSet(schema.HashString, []interface{}{"aad.admin"})
+	err := addTeamAdministrators(clients, testTeamProjectID.String(), testTeamID.String(), administrators)
+	require.Contains(t, err.Error(), "SetAccessControlEntries() Failed")
+}
+
+// verifies that a missing identity response is reported instead of being dereferenced
+func TestAzureDevOpsTeam_GetIdentityDescriptors_ErrorsOnNilResponse(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	identityClient := azdosdkmocks.NewMockIdentityClient(ctrl)
+	clients := &config.AggregatedClient{IdentityClient: identityClient, Ctx: context.Background()}
+
+	identityClient.
+		EXPECT().
+		ReadIdentities(clients.Ctx, gomock.Any()).
+		Return(nil, nil).
+		Times(2)
+
+	_, err := getIdentityDescriptors(clients, []string{"aad.admin"})
+	require.NotNil(t, err)
+	require.Contains(t, err.Error(), "aad.admin")
+
+	_, err = getSubjectDescriptors(clients, []string{"Microsoft.TeamFoundation.Identity;S-1-admin"})
+	require.NotNil(t, err)
+}
+
+// verifies that a team without an access control list has no administrators
+func TestAzureDevOpsTeam_GetAdministrators_HandlesNilAccessControlLists(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	securityClient := azdosdkmocks.NewMockSecurityClient(ctrl)
+	clients := &config.AggregatedClient{SecurityClient: securityClient, Ctx: context.Background()}
+
+	securityClient.
+		EXPECT().
+		QueryAccessControlLists(clients.Ctx, gomock.Any()).
+		Return(nil, nil).
+		Times(1)
+
+	administrators, err := getTeamAdministrators(clients, testTeamProjectID.String(), testTeamID.String())
+	require.Nil(t, err)
+	require.Equal(t, 0, administrators.Len())
+}
+
+// verifies that the import ID must contain the project and the team
+func TestAzureDevOpsTeam_Import_ChecksIDFormat(t *testing.T) {
+	resourceData := schema.TestResourceDataRaw(t, resourceTeam().Schema, nil)
+	resourceData.SetId(testTeamID.String())
+
+	_, err := importTeam(resourceData, &config.AggregatedClient{})
+	require.NotNil(t, err)
+	require.Contains(t, err.Error(), "Unexpected format of ID")
+}
+
+// verifies that the data source pages through the teams of a project
+func TestDataSourceTeams_Read_PagesThroughTeams(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	coreClient := azdosdkmocks.NewMockCoreClient(ctrl)
+	clients := &config.AggregatedClient{
+		CoreClient: coreClient,
+		Ctx:        context.Background(),
+	}
+
+	firstPage := make([]core.WebApiTeam, teamsPageSize)
+	for i := range firstPage {
+		id := uuid.New()
+		firstPage[i] = core.WebApiTeam{Id: &id, ProjectId: &testTeamProjectID, Name: converter.String(fmt.Sprintf("team-%d", i))}
+	}
+
+	coreClient.
+		EXPECT().
+		GetTeams(clients.Ctx, core.GetTeamsArgs{
+			ProjectId: converter.String(testTeamProjectID.String()),
+			Top:       converter.Int(teamsPageSize),
+			Skip:      converter.Int(0),
+		}).
+		Return(&firstPage, nil).
+		Times(1)
+	coreClient.
+		EXPECT().
+		GetTeams(clients.Ctx, core.GetTeamsArgs{
+			ProjectId: converter.String(testTeamProjectID.String()),
+			Top:       converter.Int(teamsPageSize),
+			Skip:      converter.Int(teamsPageSize),
+		}).
+		Return(&[]core.WebApiTeam{testTeam}, nil).
+		Times(1)
+
+	resourceData := schema.TestResourceDataRaw(t, dataTeams().Schema, nil)
+	resourceData.Set("project_id", testTeamProjectID.String())
+
+	err := dataSourceTeamsRead(resourceData, clients)
+	require.Nil(t, err)
+	require.Equal(t, teamsPageSize+1, resourceData.Get("teams").(*schema.Set).Len())
+}
+
+// verifies that the teams of all projects are read page by page and that an empty response ends the paging
+func TestDataSourceTeams_Read_PagesThroughAllTeams(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	coreClient := azdosdkmocks.NewMockCoreClient(ctrl)
+	clients := &config.AggregatedClient{
+		CoreClient: coreClient,
+		Ctx:        context.Background(),
+	}
+
+	firstPage := make([]core.WebApiTeam, teamsPageSize)
+	for i := range firstPage {
+		id := uuid.New()
+		firstPage[i] = core.WebApiTeam{Id: &id, ProjectId: &testTeamProjectID, Name: converter.String(fmt.Sprintf("team-%d", i))}
+	}
+
+	coreClient.
+		EXPECT().
+		GetAllTeams(clients.Ctx, core.GetAllTeamsArgs{
+			Top:  converter.Int(teamsPageSize),
+			Skip: converter.Int(0),
+		}).
+		Return(&firstPage, nil).
+		Times(1)
+	coreClient.
+		EXPECT().
+		GetAllTeams(clients.Ctx, core.GetAllTeamsArgs{
+			Top:  converter.Int(teamsPageSize),
+			Skip: converter.Int(teamsPageSize),
+		}).
+		Return(nil, nil).
+		Times(1)
+
+	resourceData := schema.TestResourceDataRaw(t, dataTeams().Schema, nil)
+
+	err := dataSourceTeamsRead(resourceData, clients)
+	require.Nil(t, err)
+	require.Equal(t, teamsPageSize, resourceData.Get("teams").(*schema.Set).Len())
+}
+
+/**
+ * Begin acceptance tests
+ */
+
+// Verifies that a team can be created, updated and found through the data source
+func TestAccAzureDevOpsTeam_CreateAndUpdate(t *testing.T) {
+	projectName := testhelper.TestAccResourcePrefix + acctest.RandStringFromCharSet(10, acctest.CharSetAlphaNum)
+	teamName := testhelper.TestAccResourcePrefix + acctest.RandStringFromCharSet(10, acctest.CharSetAlphaNum)
+	tfNode := "azuredevops_team.team"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testhelper.TestAccPreCheck(t, nil) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccTeamCheckDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testhelper.TestAccTeamResource(projectName, teamName, "first description"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(tfNode, "name", teamName),
+					resource.TestCheckResourceAttr(tfNode, "description", "first description"),
+					resource.TestCheckResourceAttrSet(tfNode, "descriptor"),
+					resource.TestCheckResourceAttr(tfNode, "administrators.#", "1"),
+					resource.TestCheckResourceAttr("data.azuredevops_teams.teams", "teams.#", "2"),
+				),
+			},
+			{
+				Config: testhelper.TestAccTeamResource(projectName, teamName, "second description"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(tfNode, "description", "second description"),
+				),
+			},
+			{
+				ResourceName:      tfNode,
+				ImportStateIdFunc: testAccTeamImportStateIDFunc(tfNode),
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccTeamImportStateIDFunc(resourceName string) resource.ImportStateIdFunc {
+	return func(s *terraform.State) (string, error) {
+		res := s.RootModule().Resources[resourceName]
+		return fmt.Sprintf("%s/%s", res.Primary.Attributes["project_id"], res.Primary.ID), nil
+	}
+}
+
+
+func init() {
+	InitProvider()
+}
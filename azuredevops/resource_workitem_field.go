@@ -0,0 +1,153 @@
+package azuredevops
+
+import (
+	"fmt"
+
+	"github.com/google/uuid"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/validation"
+	"github.com/microsoft/azure-devops-go-api/azuredevops/workitemtracking"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/utils/config"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/utils/converter"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/utils/validate"
+)
+
+// Work item fields are defined at the organization level and cannot be modified once created, so every
+// argument of this resource forces the creation of a new field.
+func resourceWorkItemField() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceWorkItemFieldCreate,
+		Read:   resourceWorkItemFieldRead,
+		Delete: resourceWorkItemFieldDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validate.NoEmptyStrings,
+			},
+			"reference_name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validate.NoEmptyStrings,
+			},
+			"type": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+				ValidateFunc: validation.StringInSlice([]string{
+					string(workitemtracking.FieldTypeValues.String),
+					string(workitemtracking.FieldTypeValues.Integer),
+					string(workitemtracking.FieldTypeValues.DateTime),
+					string(workitemtracking.FieldTypeValues.PlainText),
+					string(workitemtracking.FieldTypeValues.Html),
+					string(workitemtracking.FieldTypeValues.TreePath),
+					string(workitemtracking.FieldTypeValues.Double),
+					string(workitemtracking.FieldTypeValues.Guid),
+					string(workitemtracking.FieldTypeValues.Boolean),
+					string(workitemtracking.FieldTypeValues.Identity),
+				}, false),
+			},
+			"description": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+				Default:  "",
+			},
+			"picklist_id": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				ValidateFunc: validate.UUIDOrEmpty,
+			},
+			"url": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func resourceWorkItemFieldCreate(d *schema.ResourceData, m interface{}) error {
+	clients := m.(*config.AggregatedClient)
+	field, err := expandWorkItemField(d)
+	if err != nil {
+		return fmt.Errorf("Error converting terraform data model to AzDO work item field reference: %+v", err)
+	}
+
+	createdField, err := clients.WorkItemTrackingClient.CreateField(clients.Ctx, workitemtracking.CreateFieldArgs{
+		WorkItemField: field,
+	})
+	if err != nil {
+		return fmt.Errorf("Error creating work item field in Azure DevOps: %+v", err)
+	}
+
+	flattenWorkItemField(d, createdField)
+	return resourceWorkItemFieldRead(d, m)
+}
+
+func resourceWorkItemFieldRead(d *schema.ResourceData, m interface{}) error {
+	clients := m.(*config.AggregatedClient)
+
+	field, err := clients.WorkItemTrackingClient.GetField(clients.Ctx, workitemtracking.GetFieldArgs{
+		FieldNameOrRefName: converter.String(d.Id()),
+	})
+	if err != nil {
+		return fmt.Errorf("Error looking up work item field with reference name %s. Error: %v", d.Id(), err)
+	}
+
+	flattenWorkItemField(d, field)
+	return nil
+}
+
+func resourceWorkItemFieldDelete(d *schema.ResourceData, m interface{}) error {
+	clients := m.(*config.AggregatedClient)
+
+	return clients.WorkItemTrackingClient.DeleteField(clients.Ctx, workitemtracking.DeleteFieldArgs{
+		FieldNameOrRefName: converter.String(d.Id()),
+	})
+}
+
+func flattenWorkItemField(d *schema.ResourceData, field *workitemtracking.WorkItemField) {
+	d.SetId(*field.ReferenceName)
+	d.Set("name", converter.ToString(field.Name, ""))
+	d.Set("reference_name", *field.ReferenceName)
+	d.Set("description", converter.ToString(field.Description, ""))
+	d.Set("url", converter.ToString(field.Url, ""))
+	if field.Type != nil {
+		d.Set("type", string(*field.Type))
+	}
+	if field.PicklistId != nil {
+		d.Set("picklist_id", field.PicklistId.String())
+	} else {
+		d.Set("picklist_id", "")
+	}
+}
+
+// Convert internal Terraform data structure to an AzDO data structure
+func expandWorkItemField(d *schema.ResourceData) (*workitemtracking.WorkItemField, error) {
+	fieldType := workitemtracking.FieldType(d.Get("type").(string))
+	field := &workitemtracking.WorkItemField{
+		Name:          converter.String(d.Get("name").(string)),
+		ReferenceName: converter.String(d.Get("reference_name").(string)),
+		Description:   converter.String(d.Get("description").(string)),
+		Type:          &fieldType,
+		Usage:         &workitemtracking.FieldUsageValues.WorkItem,
+	}
+
+	if picklistID := d.Get("picklist_id").(string); picklistID != "" {
+		parsedID, err := uuid.Parse(picklistID)
+		if err != nil {
+			return nil, fmt.Errorf("Invalid picklist UUID: %s", picklistID)
+		}
+		field.IsPicklist = converter.Bool(true)
+		field.PicklistId = &parsedID
+	}
+
+	return field, nil
+}
@@ -0,0 +1,175 @@
+// +build all resource_workitem_field
+
+package azuredevops
+
+// The tests in this file use the mock clients in mock_client.go to mock out
+// the Azure DevOps client operations.
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/google/uuid"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/terraform"
+	"github.com/microsoft/azure-devops-go-api/azuredevops/workitemtracking"
+	"github.com/microsoft/terraform-provider-azuredevops/azdosdkmocks"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/utils/config"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/utils/converter"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/utils/testhelper"
+	"github.com/stretchr/testify/require"
+)
+
+var testWorkItemFieldPicklistID = uuid.New()
+
+var testWorkItemField = workitemtracking.WorkItemField{
+	Name:          converter.String("Customer Impact"),
+	ReferenceName: converter.String("Custom.CustomerImpact"),
+	Description:   converter.String("How badly customers are affected"),
+	Type:          &workitemtracking.FieldTypeValues.String,
+	Usage:         &workitemtracking.FieldUsageValues.WorkItem,
+	IsPicklist:    converter.Bool(true),
+	PicklistId:    &testWorkItemFieldPicklistID,
+}
+
+/**
+ * Begin unit tests
+ */
+
+// verifies that the flatten/expand round trip yields the same work item field
+func TestAzureDevOpsWorkItemField_ExpandFlatten_Roundtrip(t *testing.T) {
+	resourceData := schema.TestResourceDataRaw(t, resourceWorkItemField().Schema, nil)
+	flattenWorkItemField(resourceData, &testWorkItemField)
+
+	fieldAfterRoundTrip, err := expandWorkItemField(resourceData)
+	require.Nil(t, err)
+	require.Equal(t, testWorkItemField, *fieldAfterRoundTrip)
+	require.Equal(t, "Custom.CustomerImpact", resourceData.Id())
+}
+
+// verifies that a field that is not backed by a picklist is not flagged as a picklist field
+func TestAzureDevOpsWorkItemField_Expand_WithoutPicklist(t *testing.T) {
+	resourceData := schema.TestResourceDataRaw(t, resourceWorkItemField().Schema, nil)
+	resourceData.Set("name", "Customer Impact")
+	resourceData.Set("reference_name", "Custom.CustomerImpact")
+	resourceData.Set("type", "integer")
+
+	field, err := expandWorkItemField(resourceData)
+	require.Nil(t, err)
+	require.Nil(t, field.IsPicklist)
+	require.Nil(t, field.PicklistId)
+	require.Equal(t, workitemtracking.FieldTypeValues.Integer, *field.Type)
+}
+
+// verifies that the create operation is considered failed if the API call fails.
+func TestAzureDevOpsWorkItemField_Create_DoesNotSwallowError(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	witClient := azdosdkmocks.NewMockWorkitemtrackingClient(ctrl)
+	clients := &config.AggregatedClient{
+		WorkItemTrackingClient: witClient,
+		Ctx:                    context.Background(),
+	}
+
+	resourceData := schema.TestResourceDataRaw(t, resourceWorkItemField().Schema, nil)
+	flattenWorkItemField(resourceData, &testWorkItemField)
+	resourceData.SetId("")
+
+	witClient.
+		EXPECT().
+		CreateField(clients.Ctx, workitemtracking.CreateFieldArgs{WorkItemField: &testWorkItemField}).
+		Return(nil, errors.New("CreateField() Failed")).
+		Times(1)
+
+	err := resourceWorkItemFieldCreate(resourceData, clients)
+	require.Contains(t, err.Error(), "CreateField() Failed")
+}
+
+// verifies that the reference name is used to read and delete the field
+func TestAzureDevOpsWorkItemField_ReadAndDelete_UseReferenceName(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	witClient := azdosdkmocks.NewMockWorkitemtrackingClient(ctrl)
+	clients := &config.AggregatedClient{
+		WorkItemTrackingClient: witClient,
+		Ctx:                    context.Background(),
+	}
+
+	resourceData := schema.TestResourceDataRaw(t, resourceWorkItemField().Schema, nil)
+	resourceData.SetId("Custom.CustomerImpact")
+
+	witClient.
+		EXPECT().
+		GetField(clients.Ctx, workitemtracking.GetFieldArgs{FieldNameOrRefName: converter.String("Custom.CustomerImpact")}).
+		Return(nil, errors.New("GetField() Failed")).
+		Times(1)
+	witClient.
+		EXPECT().
+		DeleteField(clients.Ctx, workitemtracking.DeleteFieldArgs{FieldNameOrRefName: converter.String("Custom.CustomerImpact")}).
+		Return(errors.New("DeleteField() Failed")).
+		Times(1)
+
+	err := resourceWorkItemFieldRead(resourceData, clients)
+	require.Contains(t, err.Error(), "GetField() Failed")
+
+	err = resourceWorkItemFieldDelete(resourceData, clients)
+	require.Contains(t, err.Error(), "DeleteField() Failed")
+}
+
+/**
+ * Begin acceptance tests
+ */
+
+// Verifies that a work item field backed by a picklist can be created and destroyed
+func TestAccAzureDevOpsWorkItemField_CreateWithPicklist(t *testing.T) {
+	suffix := acctest.RandStringFromCharSet(10, acctest.CharSetAlpha)
+	fieldName := "TestAcc" + suffix
+	referenceName := "Custom.TestAcc" + suffix
+	tfNode := "azuredevops_workitem_field.field"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testhelper.TestAccPreCheck(t, nil) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccWorkItemFieldCheckDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testhelper.TestAccWorkItemFieldResource(fieldName, referenceName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(tfNode, "name", fieldName),
+					resource.TestCheckResourceAttr(tfNode, "reference_name", referenceName),
+					resource.TestCheckResourceAttrPair(tfNode, "picklist_id", "azuredevops_workitem_picklist.picklist", "id"),
+				),
+			},
+		},
+	})
+}
+
+// verifies that the work item field referenced in the state is destroyed.
+func testAccWorkItemFieldCheckDestroy(s *terraform.State) error {
+	clients := testAccProvider.Meta().(*config.AggregatedClient)
+
+	for _, resource := range s.RootModule().Resources {
+		if resource.Type != "azuredevops_workitem_field" {
+			continue
+		}
+
+		// indicates the field still exists - this should fail the test
+		args := workitemtracking.GetFieldArgs{FieldNameOrRefName: converter.String(resource.Primary.ID)}
+		if _, err := clients.WorkItemTrackingClient.GetField(clients.Ctx, args); err == nil {
+			return fmt.Errorf("Work item field %s should not exist", resource.Primary.ID)
+		}
+	}
+
+	return nil
+}
+
+func init() {
+	InitProvider()
+}
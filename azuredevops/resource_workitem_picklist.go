@@ -0,0 +1,159 @@
+package azuredevops
+
+import (
+	"fmt"
+
+	"github.com/google/uuid"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/validation"
+	"github.com/microsoft/azure-devops-go-api/azuredevops/workitemtrackingprocess"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/utils/config"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/utils/converter"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/utils/validate"
+)
+
+func resourceWorkItemPicklist() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceWorkItemPicklistCreate,
+		Read:   resourceWorkItemPicklistRead,
+		Update: resourceWorkItemPicklistUpdate,
+		Delete: resourceWorkItemPicklistDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validate.NoEmptyStrings,
+			},
+			"type": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				Default:      "String",
+				ValidateFunc: validation.StringInSlice([]string{"String", "Integer", "Double"}, false),
+			},
+			"is_suggested": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+			"items": {
+				Type:     schema.TypeList,
+				Required: true,
+				MinItems: 1,
+				Elem: &schema.Schema{
+					Type:         schema.TypeString,
+					ValidateFunc: validate.NoEmptyStrings,
+				},
+			},
+			"url": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func resourceWorkItemPicklistCreate(d *schema.ResourceData, m interface{}) error {
+	clients := m.(*config.AggregatedClient)
+	picklist, err := expandWorkItemPicklist(d)
+	if err != nil {
+		return fmt.Errorf("Error converting terraform data model to AzDO picklist reference: %+v", err)
+	}
+
+	createdPicklist, err := clients.WorkItemTrackingProcessClient.CreateList(clients.Ctx, workitemtrackingprocess.CreateListArgs{
+		Picklist: picklist,
+	})
+	if err != nil {
+		return fmt.Errorf("Error creating picklist in Azure DevOps: %+v", err)
+	}
+
+	flattenWorkItemPicklist(d, createdPicklist)
+	return resourceWorkItemPicklistRead(d, m)
+}
+
+func resourceWorkItemPicklistRead(d *schema.ResourceData, m interface{}) error {
+	clients := m.(*config.AggregatedClient)
+	listID, err := uuid.Parse(d.Id())
+	if err != nil {
+		return fmt.Errorf("Invalid picklist UUID: %s", d.Id())
+	}
+
+	picklist, err := clients.WorkItemTrackingProcessClient.GetList(clients.Ctx, workitemtrackingprocess.GetListArgs{
+		ListId: &listID,
+	})
+	if err != nil {
+		return fmt.Errorf("Error looking up picklist with ID %s. Error: %v", d.Id(), err)
+	}
+
+	flattenWorkItemPicklist(d, picklist)
+	return nil
+}
+
+func resourceWorkItemPicklistUpdate(d *schema.ResourceData, m interface{}) error {
+	clients := m.(*config.AggregatedClient)
+	picklist, err := expandWorkItemPicklist(d)
+	if err != nil {
+		return fmt.Errorf("Error converting terraform data model to AzDO picklist reference: %+v", err)
+	}
+
+	_, err = clients.WorkItemTrackingProcessClient.UpdateList(clients.Ctx, workitemtrackingprocess.UpdateListArgs{
+		Picklist: picklist,
+		ListId:   picklist.Id,
+	})
+	if err != nil {
+		return fmt.Errorf("Error updating picklist in Azure DevOps: %+v", err)
+	}
+
+	return resourceWorkItemPicklistRead(d, m)
+}
+
+func resourceWorkItemPicklistDelete(d *schema.ResourceData, m interface{}) error {
+	clients := m.(*config.AggregatedClient)
+	listID, err := uuid.Parse(d.Id())
+	if err != nil {
+		return fmt.Errorf("Invalid picklist UUID: %s", d.Id())
+	}
+
+	return clients.WorkItemTrackingProcessClient.DeleteList(clients.Ctx, workitemtrackingprocess.DeleteListArgs{
+		ListId: &listID,
+	})
+}
+
+func flattenWorkItemPicklist(d *schema.ResourceData, picklist *workitemtrackingprocess.PickList) {
+	d.SetId(picklist.Id.String())
+	d.Set("name", converter.ToString(picklist.Name, ""))
+	d.Set("type", converter.ToString(picklist.Type, ""))
+	d.Set("is_suggested", converter.ToBool(picklist.IsSuggested, false))
+	d.Set("url", converter.ToString(picklist.Url, ""))
+	if picklist.Items != nil {
+		d.Set("items", *picklist.Items)
+	}
+}
+
+// Convert internal Terraform data structure to an AzDO data structure
+func expandWorkItemPicklist(d *schema.ResourceData) (*workitemtrackingprocess.PickList, error) {
+	// an "error" is OK here as it is expected in the case that the ID is not set in the resource data
+	var listID *uuid.UUID
+	parsedID, err := uuid.Parse(d.Id())
+	if err == nil {
+		listID = &parsedID
+	}
+
+	rawItems := d.Get("items").([]interface{})
+	items := make([]string, len(rawItems))
+	for i, item := range rawItems {
+		items[i] = item.(string)
+	}
+
+	return &workitemtrackingprocess.PickList{
+		Id:          listID,
+		Name:        converter.String(d.Get("name").(string)),
+		Type:        converter.String(d.Get("type").(string)),
+		IsSuggested: converter.Bool(d.Get("is_suggested").(bool)),
+		Items:       &items,
+	}, nil
+}
@@ -0,0 +1,178 @@
+// +build all resource_workitem_picklist
+
+package azuredevops
+
+// The tests in this file use the mock clients in mock_client.go to mock out
+// the Azure DevOps client operations.
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/google/uuid"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/terraform"
+	"github.com/microsoft/azure-devops-go-api/azuredevops/workitemtrackingprocess"
+	"github.com/microsoft/terraform-provider-azuredevops/azdosdkmocks"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/utils/config"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/utils/converter"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/utils/testhelper"
+	"github.com/stretchr/testify/require"
+)
+
+var testPicklistID = uuid.New()
+
+var testPicklist = workitemtrackingprocess.PickList{
+	Id:          &testPicklistID,
+	Name:        converter.String("Customer Impact"),
+	Type:        converter.String("String"),
+	IsSuggested: converter.Bool(false),
+	Items:       &[]string{"Low", "Medium", "High"},
+}
+
+/**
+ * Begin unit tests
+ */
+
+// verifies that the flatten/expand round trip yields the same picklist
+func TestAzureDevOpsWorkItemPicklist_ExpandFlatten_Roundtrip(t *testing.T) {
+	resourceData := schema.TestResourceDataRaw(t, resourceWorkItemPicklist().Schema, nil)
+	flattenWorkItemPicklist(resourceData, &testPicklist)
+
+	picklistAfterRoundTrip, err := expandWorkItemPicklist(resourceData)
+	require.Nil(t, err)
+	require.Equal(t, testPicklist, *picklistAfterRoundTrip)
+}
+
+// verifies that the create operation is considered failed if the API call fails.
+func TestAzureDevOpsWorkItemPicklist_Create_DoesNotSwallowError(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	processClient := azdosdkmocks.NewMockWorkitemtrackingprocessClient(ctrl)
+	clients := &config.AggregatedClient{
+		WorkItemTrackingProcessClient: processClient,
+		Ctx:                           context.Background(),
+	}
+
+	resourceData := schema.TestResourceDataRaw(t, resourceWorkItemPicklist().Schema, nil)
+	flattenWorkItemPicklist(resourceData, &testPicklist)
+	resourceData.SetId("")
+
+	processClient.
+		EXPECT().
+		CreateList(clients.Ctx, gomock.Any()).
+		Return(nil, errors.New("CreateList() Failed")).
+		Times(1)
+
+	err := resourceWorkItemPicklistCreate(resourceData, clients)
+	require.Contains(t, err.Error(), "CreateList() Failed")
+}
+
+// verifies that the read operation is considered failed if the API call fails.
+func TestAzureDevOpsWorkItemPicklist_Read_DoesNotSwallowError(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	processClient := azdosdkmocks.NewMockWorkitemtrackingprocessClient(ctrl)
+	clients := &config.AggregatedClient{
+		WorkItemTrackingProcessClient: processClient,
+		Ctx:                           context.Background(),
+	}
+
+	resourceData := schema.TestResourceDataRaw(t, resourceWorkItemPicklist().Schema, nil)
+	flattenWorkItemPicklist(resourceData, &testPicklist)
+
+	processClient.
+		EXPECT().
+		GetList(clients.Ctx, workitemtrackingprocess.GetListArgs{ListId: &testPicklistID}).
+		Return(nil, errors.New("GetList() Failed")).
+		Times(1)
+
+	err := resourceWorkItemPicklistRead(resourceData, clients)
+	require.Contains(t, err.Error(), "GetList() Failed")
+}
+
+func TestAzureDevOpsWorkItemPicklist_Delete_ChecksForValidUUID(t *testing.T) {
+	resourceData := schema.TestResourceDataRaw(t, resourceWorkItemPicklist().Schema, nil)
+	resourceData.SetId("not-a-uuid-id")
+
+	err := resourceWorkItemPicklistDelete(resourceData, &config.AggregatedClient{})
+	require.NotNil(t, err)
+	require.Contains(t, err.Error(), "Invalid picklist UUID")
+}
+
+/**
+ * Begin acceptance tests
+ */
+
+// Verifies that the following sequence of events occurrs without error:
+//	(1) TF apply creates picklist
+//	(2) TF state values are set
+//	(3) TF apply updates the picklist items
+// 	(4) TF destroy deletes picklist
+//	(5) picklist can no longer be queried by ID
+func TestAccAzureDevOpsWorkItemPicklist_CreateAndUpdate(t *testing.T) {
+	picklistName := testhelper.TestAccResourcePrefix + acctest.RandStringFromCharSet(10, acctest.CharSetAlphaNum)
+	tfNode := "azuredevops_workitem_picklist.picklist"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testhelper.TestAccPreCheck(t, nil) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccWorkItemPicklistCheckDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testhelper.TestAccWorkItemPicklistResource(picklistName, []string{"Low", "High"}),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(tfNode, "name", picklistName),
+					resource.TestCheckResourceAttr(tfNode, "items.#", "2"),
+					resource.TestCheckResourceAttrSet(tfNode, "url"),
+				),
+			},
+			{
+				Config: testhelper.TestAccWorkItemPicklistResource(picklistName, []string{"Low", "Medium", "High"}),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(tfNode, "name", picklistName),
+					resource.TestCheckResourceAttr(tfNode, "items.#", "3"),
+				),
+			},
+			{
+				ResourceName:      tfNode,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+// verifies that the picklist referenced in the state is destroyed.
+func testAccWorkItemPicklistCheckDestroy(s *terraform.State) error {
+	clients := testAccProvider.Meta().(*config.AggregatedClient)
+
+	for _, resource := range s.RootModule().Resources {
+		if resource.Type != "azuredevops_workitem_picklist" {
+			continue
+		}
+
+		id, err := uuid.Parse(resource.Primary.ID)
+		if err != nil {
+			return fmt.Errorf("Picklist ID=%s cannot be parsed!. Error=%v", resource.Primary.ID, err)
+		}
+
+		// indicates the picklist still exists - this should fail the test
+		if _, err := clients.WorkItemTrackingProcessClient.GetList(clients.Ctx, workitemtrackingprocess.GetListArgs{ListId: &id}); err == nil {
+			return fmt.Errorf("Picklist ID %s should not exist", resource.Primary.ID)
+		}
+	}
+
+	return nil
+}
+
+func init() {
+	InitProvider()
+}
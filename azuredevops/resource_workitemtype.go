@@ -0,0 +1,223 @@
+package azuredevops
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/google/uuid"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/microsoft/azure-devops-go-api/azuredevops/workitemtrackingprocess"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/utils/config"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/utils/converter"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/utils/validate"
+)
+
+func resourceWorkItemType() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceWorkItemTypeCreate,
+		Read:   resourceWorkItemTypeRead,
+		Update: resourceWorkItemTypeUpdate,
+		Delete: resourceWorkItemTypeDelete,
+		Importer: &schema.ResourceImporter{
+			State: importWorkItemType,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"process_id": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validate.UUID,
+			},
+			"name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validate.NoEmptyStrings,
+			},
+			"inherits_from": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+				Default:  "",
+			},
+			"description": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Default:  "",
+			},
+			"color": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+			},
+			"icon": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+			},
+			"is_disabled": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+			"reference_name": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"url": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func resourceWorkItemTypeCreate(d *schema.ResourceData, m interface{}) error {
+	clients := m.(*config.AggregatedClient)
+	processID, err := uuid.Parse(d.Get("process_id").(string))
+	if err != nil {
+		return fmt.Errorf("Invalid process UUID: %s", d.Get("process_id").(string))
+	}
+
+	createdWorkItemType, err := clients.WorkItemTrackingProcessClient.CreateProcessWorkItemType(clients.Ctx, workitemtrackingprocess.CreateProcessWorkItemTypeArgs{
+		ProcessId: &processID,
+		WorkItemType: &workitemtrackingprocess.CreateProcessWorkItemTypeRequest{
+			Name:         converter.String(d.Get("name").(string)),
+			InheritsFrom: converter.String(d.Get("inherits_from").(string)),
+			Description:  converter.String(d.Get("description").(string)),
+			Color:        converter.String(d.Get("color").(string)),
+			Icon:         converter.String(d.Get("icon").(string)),
+			IsDisabled:   converter.Bool(d.Get("is_disabled").(bool)),
+		},
+	})
+	if err != nil {
+		return fmt.Errorf("Error creating work item type in Azure DevOps: %+v", err)
+	}
+
+	flattenWorkItemType(d, createdWorkItemType)
+	return resourceWorkItemTypeRead(d, m)
+}
+
+func resourceWorkItemTypeRead(d *schema.ResourceData, m interface{}) error {
+	clients := m.(*config.AggregatedClient)
+	processID, err := uuid.Parse(d.Get("process_id").(string))
+	if err != nil {
+		return fmt.Errorf("Invalid process UUID: %s", d.Get("process_id").(string))
+	}
+
+	workItemType, err := clients.WorkItemTrackingProcessClient.GetProcessWorkItemType(clients.Ctx, workitemtrackingprocess.GetProcessWorkItemTypeArgs{
+		ProcessId:  &processID,
+		WitRefName: converter.String(d.Id()),
+	})
+	if err != nil {
+		return fmt.Errorf("Error looking up work item type with reference name %s in process %s. Error: %v", d.Id(), processID.String(), err)
+	}
+
+	flattenWorkItemType(d, workItemType)
+	return nil
+}
+
+func resourceWorkItemTypeUpdate(d *schema.ResourceData, m interface{}) error {
+	clients := m.(*config.AggregatedClient)
+	processID, err := uuid.Parse(d.Get("process_id").(string))
+	if err != nil {
+		return fmt.Errorf("Invalid process UUID: %s", d.Get("process_id").(string))
+	}
+
+	_, err = clients.WorkItemTrackingProcessClient.UpdateProcessWorkItemType(clients.Ctx, workitemtrackingprocess.UpdateProcessWorkItemTypeArgs{
+		ProcessId:  &processID,
+		WitRefName: converter.String(d.Id()),
+		WorkItemTypeUpdate: &workitemtrackingprocess.UpdateProcessWorkItemTypeRequest{
+			Description: converter.String(d.Get("description").(string)),
+			Color:       converter.String(d.Get("color").(string)),
+			Icon:        converter.String(d.Get("icon").(string)),
+			IsDisabled:  converter.Bool(d.Get("is_disabled").(bool)),
+		},
+	})
+	if err != nil {
+		return fmt.Errorf("Error updating work item type in Azure DevOps: %+v", err)
+	}
+
+	return resourceWorkItemTypeRead(d, m)
+}
+
+func resourceWorkItemTypeDelete(d *schema.ResourceData, m interface{}) error {
+	clients := m.(*config.AggregatedClient)
+	processID, err := uuid.Parse(d.Get("process_id").(string))
+	if err != nil {
+		return fmt.Errorf("Invalid process UUID: %s", d.Get("process_id").(string))
+	}
+
+	return clients.WorkItemTrackingProcessClient.DeleteProcessWorkItemType(clients.Ctx, workitemtrackingprocess.DeleteProcessWorkItemTypeArgs{
+		ProcessId:  &processID,
+		WitRefName: converter.String(d.Id()),
+	})
+}
+
+func flattenWorkItemType(d *schema.ResourceData, workItemType *workitemtrackingprocess.ProcessWorkItemType) {
+	d.SetId(*workItemType.ReferenceName)
+	d.Set("name", converter.ToString(workItemType.Name, ""))
+	d.Set("inherits_from", converter.ToString(workItemType.Inherits, ""))
+	d.Set("description", converter.ToString(workItemType.Description, ""))
+	d.Set("color", converter.ToString(workItemType.Color, ""))
+	d.Set("icon", converter.ToString(workItemType.Icon, ""))
+	d.Set("is_disabled", converter.ToBool(workItemType.IsDisabled, false))
+	d.Set("reference_name", *workItemType.ReferenceName)
+	d.Set("url", converter.ToString(workItemType.Url, ""))
+}
+
+// The import ID of a work item type is processId/workItemTypeReferenceName
+func importWorkItemType(d *schema.ResourceData, m interface{}) ([]*schema.ResourceData, error) {
+	parts := strings.SplitN(d.Id(), "/", 2)
+	if len(parts) != 2 || parts[0] == "" || parts[1] == "" {
+		return nil, fmt.Errorf("unexpected format of ID (%s), expected processId/workItemTypeReferenceName", d.Id())
+	}
+
+	d.Set("process_id", parts[0])
+	d.SetId(parts[1])
+	return []*schema.ResourceData{d}, nil
+}
+
+// The import ID of an object owned by a work item type (field, state, rule, ...) is
+// processId/workItemTypeReferenceName/objectId
+func importWorkItemTypeChild(d *schema.ResourceData, m interface{}) ([]*schema.ResourceData, error) {
+	parts := strings.SplitN(d.Id(), "/", 3)
+	if len(parts) != 3 || parts[0] == "" || parts[1] == "" || parts[2] == "" {
+		return nil, fmt.Errorf("unexpected format of ID (%s), expected processId/workItemTypeReferenceName/id", d.Id())
+	}
+
+	d.Set("process_id", parts[0])
+	d.Set("work_item_type_id", parts[1])
+	d.SetId(parts[2])
+	return []*schema.ResourceData{d}, nil
+}
+
+// The schema attributes that every object owned by a work item type needs in order to address its parent
+func workItemTypeChildSchema() map[string]*schema.Schema {
+	return map[string]*schema.Schema{
+		"process_id": {
+			Type:         schema.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: validate.UUID,
+		},
+		"work_item_type_id": {
+			Type:         schema.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: validate.NoEmptyStrings,
+		},
+	}
+}
+
+// Parse the process ID and work item type reference name that identify the parent of an object owned by
+// a work item type
+func parseWorkItemTypeScope(d *schema.ResourceData) (*uuid.UUID, *string, error) {
+	processID, err := uuid.Parse(d.Get("process_id").(string))
+	if err != nil {
+		return nil, nil, fmt.Errorf("Invalid process UUID: %s", d.Get("process_id").(string))
+	}
+
+	return &processID, converter.String(d.Get("work_item_type_id").(string)), nil
+}
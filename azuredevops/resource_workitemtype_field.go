@@ -0,0 +1,170 @@
+package azuredevops
+
+import (
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/microsoft/azure-devops-go-api/azuredevops/workitemtrackingprocess"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/utils/config"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/utils/converter"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/utils/validate"
+)
+
+func resourceWorkItemTypeField() *schema.Resource {
+	s := workItemTypeChildSchema()
+	s["field_id"] = &schema.Schema{
+		Type:         schema.TypeString,
+		Required:     true,
+		ForceNew:     true,
+		ValidateFunc: validate.NoEmptyStrings,
+	}
+	s["default_value"] = &schema.Schema{
+		Type:     schema.TypeString,
+		Optional: true,
+		Default:  "",
+	}
+	s["required"] = &schema.Schema{
+		Type:     schema.TypeBool,
+		Optional: true,
+		Default:  false,
+	}
+	s["read_only"] = &schema.Schema{
+		Type:     schema.TypeBool,
+		Optional: true,
+		Default:  false,
+	}
+	s["allow_groups"] = &schema.Schema{
+		Type:     schema.TypeBool,
+		Optional: true,
+		Default:  false,
+	}
+	s["name"] = &schema.Schema{
+		Type:     schema.TypeString,
+		Computed: true,
+	}
+	s["type"] = &schema.Schema{
+		Type:     schema.TypeString,
+		Computed: true,
+	}
+
+	return &schema.Resource{
+		Create: resourceWorkItemTypeFieldCreate,
+		Read:   resourceWorkItemTypeFieldRead,
+		Update: resourceWorkItemTypeFieldUpdate,
+		Delete: resourceWorkItemTypeFieldDelete,
+		Importer: &schema.ResourceImporter{
+			State: importWorkItemTypeChild,
+		},
+		Schema: s,
+	}
+}
+
+func resourceWorkItemTypeFieldCreate(d *schema.ResourceData, m interface{}) error {
+	clients := m.(*config.AggregatedClient)
+	processID, witRefName, err := parseWorkItemTypeScope(d)
+	if err != nil {
+		return err
+	}
+
+	field, err := clients.WorkItemTrackingProcessClient.AddFieldToWorkItemType(clients.Ctx, workitemtrackingprocess.AddFieldToWorkItemTypeArgs{
+		ProcessId:  processID,
+		WitRefName: witRefName,
+		Field: &workitemtrackingprocess.AddProcessWorkItemTypeFieldRequest{
+			ReferenceName: converter.String(d.Get("field_id").(string)),
+			DefaultValue:  expandWorkItemTypeFieldDefaultValue(d),
+			Required:      converter.Bool(d.Get("required").(bool)),
+			ReadOnly:      converter.Bool(d.Get("read_only").(bool)),
+			AllowGroups:   converter.Bool(d.Get("allow_groups").(bool)),
+		},
+	})
+	if err != nil {
+		return fmt.Errorf("Error adding field to work item type in Azure DevOps: %+v", err)
+	}
+
+	flattenWorkItemTypeField(d, field)
+	return resourceWorkItemTypeFieldRead(d, m)
+}
+
+func resourceWorkItemTypeFieldRead(d *schema.ResourceData, m interface{}) error {
+	clients := m.(*config.AggregatedClient)
+	processID, witRefName, err := parseWorkItemTypeScope(d)
+	if err != nil {
+		return err
+	}
+
+	field, err := clients.WorkItemTrackingProcessClient.GetWorkItemTypeField(clients.Ctx, workitemtrackingprocess.GetWorkItemTypeFieldArgs{
+		ProcessId:    processID,
+		WitRefName:   witRefName,
+		FieldRefName: converter.String(d.Id()),
+	})
+	if err != nil {
+		return fmt.Errorf("Error looking up field %s of work item type %s. Error: %v", d.Id(), *witRefName, err)
+	}
+
+	flattenWorkItemTypeField(d, field)
+	return nil
+}
+
+func resourceWorkItemTypeFieldUpdate(d *schema.ResourceData, m interface{}) error {
+	clients := m.(*config.AggregatedClient)
+	processID, witRefName, err := parseWorkItemTypeScope(d)
+	if err != nil {
+		return err
+	}
+
+	_, err = clients.WorkItemTrackingProcessClient.UpdateWorkItemTypeField(clients.Ctx, workitemtrackingprocess.UpdateWorkItemTypeFieldArgs{
+		ProcessId:    processID,
+		WitRefName:   witRefName,
+		FieldRefName: converter.String(d.Id()),
+		Field: &workitemtrackingprocess.UpdateProcessWorkItemTypeFieldRequest{
+			DefaultValue: expandWorkItemTypeFieldDefaultValue(d),
+			Required:     converter.Bool(d.Get("required").(bool)),
+			ReadOnly:     converter.Bool(d.Get("read_only").(bool)),
+			AllowGroups:  converter.Bool(d.Get("allow_groups").(bool)),
+		},
+	})
+	if err != nil {
+		return fmt.Errorf("Error updating field of work item type in Azure DevOps: %+v", err)
+	}
+
+	return resourceWorkItemTypeFieldRead(d, m)
+}
+
+func resourceWorkItemTypeFieldDelete(d *schema.ResourceData, m interface{}) error {
+	clients := m.(*config.AggregatedClient)
+	processID, witRefName, err := parseWorkItemTypeScope(d)
+	if err != nil {
+		return err
+	}
+
+	return clients.WorkItemTrackingProcessClient.RemoveWorkItemTypeField(clients.Ctx, workitemtrackingprocess.RemoveWorkItemTypeFieldArgs{
+		ProcessId:    processID,
+		WitRefName:   witRefName,
+		FieldRefName: converter.String(d.Id()),
+	})
+}
+
+// The API returns an untyped default value, so the value is stored in its string representation
+func expandWorkItemTypeFieldDefaultValue(d *schema.ResourceData) interface{} {
+	if defaultValue := d.Get("default_value").(string); defaultValue != "" {
+		return defaultValue
+	}
+	return nil
+}
+
+func flattenWorkItemTypeField(d *schema.ResourceData, field *workitemtrackingprocess.ProcessWorkItemTypeField) {
+	d.SetId(*field.ReferenceName)
+	d.Set("field_id", *field.ReferenceName)
+	d.Set("name", converter.ToString(field.Name, ""))
+	d.Set("required", converter.ToBool(field.Required, false))
+	d.Set("read_only", converter.ToBool(field.ReadOnly, false))
+	d.Set("allow_groups", converter.ToBool(field.AllowGroups, false))
+	if field.Type != nil {
+		d.Set("type", string(*field.Type))
+	}
+	if field.DefaultValue != nil {
+		d.Set("default_value", fmt.Sprintf("%v", field.DefaultValue))
+	} else {
+		d.Set("default_value", "")
+	}
+}
@@ -0,0 +1,151 @@
+// +build all resource_workitemtype_field
+
+package azuredevops
+
+// The tests in this file use the mock clients in mock_client.go to mock out
+// the Azure DevOps client operations.
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/google/uuid"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/microsoft/azure-devops-go-api/azuredevops/workitemtrackingprocess"
+	"github.com/microsoft/terraform-provider-azuredevops/azdosdkmocks"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/utils/config"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/utils/converter"
+	"github.com/stretchr/testify/require"
+)
+
+var testWorkItemTypeFieldProcessID = uuid.New()
+
+var testWorkItemTypeField = workitemtrackingprocess.ProcessWorkItemTypeField{
+	Name:          converter.String("Customer Impact"),
+	ReferenceName: converter.String("Custom.CustomerImpact"),
+	DefaultValue:  "Low",
+	Required:      converter.Bool(true),
+	ReadOnly:      converter.Bool(false),
+	AllowGroups:   converter.Bool(false),
+}
+
+func getWorkItemTypeFieldResourceData(t *testing.T) *schema.ResourceData {
+	resourceData := schema.TestResourceDataRaw(t, resourceWorkItemTypeField().Schema, nil)
+	resourceData.Set("process_id", testWorkItemTypeFieldProcessID.String())
+	resourceData.Set("work_item_type_id", "MyAgile.CustomerRequest")
+	flattenWorkItemTypeField(resourceData, &testWorkItemTypeField)
+	return resourceData
+}
+
+/**
+ * Begin unit tests
+ */
+
+// verifies that the create operation sends the configured field and does not swallow errors
+func TestAzureDevOpsWorkItemTypeField_Create_DoesNotSwallowError(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	processClient := azdosdkmocks.NewMockWorkitemtrackingprocessClient(ctrl)
+	clients := &config.AggregatedClient{
+		WorkItemTrackingProcessClient: processClient,
+		Ctx:                           context.Background(),
+	}
+
+	resourceData := getWorkItemTypeFieldResourceData(t)
+	resourceData.SetId("")
+
+	expectedArgs := workitemtrackingprocess.AddFieldToWorkItemTypeArgs{
+		ProcessId:  &testWorkItemTypeFieldProcessID,
+		WitRefName: converter.String("MyAgile.CustomerRequest"),
+		Field: &workitemtrackingprocess.AddProcessWorkItemTypeFieldRequest{
+			ReferenceName: testWorkItemTypeField.ReferenceName,
+			DefaultValue:  "Low",
+			Required:      converter.Bool(true),
+			ReadOnly:      converter.Bool(false),
+			AllowGroups:   converter.Bool(false),
+		},
+	}
+	processClient.
+		EXPECT().
+		AddFieldToWorkItemType(clients.Ctx, expectedArgs).
+		Return(nil, errors.New("AddFieldToWorkItemType() Failed")).
+		Times(1)
+
+	err := resourceWorkItemTypeFieldCreate(resourceData, clients)
+	require.Contains(t, err.Error(), "AddFieldToWorkItemType() Failed")
+}
+
+// verifies that an empty default value is not sent to the service
+func TestAzureDevOpsWorkItemTypeField_Update_OmitsEmptyDefaultValue(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	processClient := azdosdkmocks.NewMockWorkitemtrackingprocessClient(ctrl)
+	clients := &config.AggregatedClient{
+		WorkItemTrackingProcessClient: processClient,
+		Ctx:                           context.Background(),
+	}
+
+	resourceData := getWorkItemTypeFieldResourceData(t)
+	resourceData.Set("default_value", "")
+
+	expectedArgs := workitemtrackingprocess.UpdateWorkItemTypeFieldArgs{
+		ProcessId:    &testWorkItemTypeFieldProcessID,
+		WitRefName:   converter.String("MyAgile.CustomerRequest"),
+		FieldRefName: testWorkItemTypeField.ReferenceName,
+		Field: &workitemtrackingprocess.UpdateProcessWorkItemTypeFieldRequest{
+			Required:    converter.Bool(true),
+			ReadOnly:    converter.Bool(false),
+			AllowGroups: converter.Bool(false),
+		},
+	}
+	processClient.
+		EXPECT().
+		UpdateWorkItemTypeField(clients.Ctx, expectedArgs).
+		Return(nil, errors.New("UpdateWorkItemTypeField() Failed")).
+		Times(1)
+
+	err := resourceWorkItemTypeFieldUpdate(resourceData, clients)
+	require.Contains(t, err.Error(), "UpdateWorkItemTypeField() Failed")
+}
+
+// verifies that the read operation is considered failed if the API call fails.
+func TestAzureDevOpsWorkItemTypeField_Read_DoesNotSwallowError(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	processClient := azdosdkmocks.NewMockWorkitemtrackingprocessClient(ctrl)
+	clients := &config.AggregatedClient{
+		WorkItemTrackingProcessClient: processClient,
+		Ctx:                           context.Background(),
+	}
+
+	resourceData := getWorkItemTypeFieldResourceData(t)
+
+	processClient.
+		EXPECT().
+		GetWorkItemTypeField(clients.Ctx, workitemtrackingprocess.GetWorkItemTypeFieldArgs{
+			ProcessId:    &testWorkItemTypeFieldProcessID,
+			WitRefName:   converter.String("MyAgile.CustomerRequest"),
+			FieldRefName: testWorkItemTypeField.ReferenceName,
+		}).
+		Return(nil, errors.New("GetWorkItemTypeField() Failed")).
+		Times(1)
+
+	err := resourceWorkItemTypeFieldRead(resourceData, clients)
+	require.Contains(t, err.Error(), "GetWorkItemTypeField() Failed")
+}
+
+// verifies that the default value is flattened into its string representation
+func TestAzureDevOpsWorkItemTypeField_Flatten_StringifiesDefaultValue(t *testing.T) {
+	resourceData := schema.TestResourceDataRaw(t, resourceWorkItemTypeField().Schema, nil)
+	field := testWorkItemTypeField
+	field.DefaultValue = float64(3)
+
+	flattenWorkItemTypeField(resourceData, &field)
+	require.Equal(t, "3", resourceData.Get("default_value"))
+	require.Equal(t, "Custom.CustomerImpact", resourceData.Id())
+}
@@ -0,0 +1,376 @@
+package azuredevops
+
+import (
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/microsoft/azure-devops-go-api/azuredevops/workitemtrackingprocess"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/utils/config"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/utils/converter"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/utils/validate"
+)
+
+func resourceWorkItemTypeGroup() *schema.Resource {
+	s := workItemTypeChildSchema()
+	s["page_id"] = &schema.Schema{
+		Type:     schema.TypeString,
+		Optional: true,
+		Computed: true,
+		ForceNew: true,
+	}
+	s["section_id"] = &schema.Schema{
+		Type:         schema.TypeString,
+		Optional:     true,
+		ForceNew:     true,
+		Default:      "Section1",
+		ValidateFunc: validate.NoEmptyStrings,
+	}
+	s["label"] = &schema.Schema{
+		Type:         schema.TypeString,
+		Required:     true,
+		ValidateFunc: validate.NoEmptyStrings,
+	}
+	s["visible"] = &schema.Schema{
+		Type:     schema.TypeBool,
+		Optional: true,
+		Default:  true,
+	}
+	s["order"] = &schema.Schema{
+		Type:     schema.TypeInt,
+		Optional: true,
+		Computed: true,
+	}
+	s["control"] = &schema.Schema{
+		Type:     schema.TypeList,
+		Optional: true,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"id": {
+					Type:         schema.TypeString,
+					Required:     true,
+					ValidateFunc: validate.NoEmptyStrings,
+				},
+				"label": {
+					Type:     schema.TypeString,
+					Optional: true,
+					Default:  "",
+				},
+				"read_only": {
+					Type:     schema.TypeBool,
+					Optional: true,
+					Default:  false,
+				},
+				"visible": {
+					Type:     schema.TypeBool,
+					Optional: true,
+					Default:  true,
+				},
+			},
+		},
+	}
+
+	return &schema.Resource{
+		Create: resourceWorkItemTypeGroupCreate,
+		Read:   resourceWorkItemTypeGroupRead,
+		Update: resourceWorkItemTypeGroupUpdate,
+		Delete: resourceWorkItemTypeGroupDelete,
+		Importer: &schema.ResourceImporter{
+			State: importWorkItemTypeChild,
+		},
+		Schema: s,
+	}
+}
+
+func resourceWorkItemTypeGroupCreate(d *schema.ResourceData, m interface{}) error {
+	clients := m.(*config.AggregatedClient)
+	processID, witRefName, err := parseWorkItemTypeScope(d)
+	if err != nil {
+		return err
+	}
+
+	pageID := d.Get("page_id").(string)
+	if pageID == "" {
+		layout, err := getWorkItemTypeLayout(clients, d)
+		if err != nil {
+			return err
+		}
+		page := selectDefaultLayoutPage(layout)
+		if page == nil {
+			return fmt.Errorf("Could not find a page in the layout of work item type %s to add the group to", *witRefName)
+		}
+		pageID = *page.Id
+	}
+
+	group, err := clients.WorkItemTrackingProcessClient.AddGroup(clients.Ctx, workitemtrackingprocess.AddGroupArgs{
+		ProcessId:  processID,
+		WitRefName: witRefName,
+		PageId:     &pageID,
+		SectionId:  converter.String(d.Get("section_id").(string)),
+		Group:      expandWorkItemTypeGroup(d),
+	})
+	if err != nil {
+		return fmt.Errorf("Error adding group to the layout of work item type in Azure DevOps: %+v", err)
+	}
+
+	d.SetId(*group.Id)
+	d.Set("page_id", pageID)
+
+	for _, control := range expandWorkItemTypeGroupControls(d.Get("control").([]interface{})) {
+		if err := addWorkItemTypeGroupControl(clients, d, control); err != nil {
+			return err
+		}
+	}
+
+	return resourceWorkItemTypeGroupRead(d, m)
+}
+
+func resourceWorkItemTypeGroupRead(d *schema.ResourceData, m interface{}) error {
+	clients := m.(*config.AggregatedClient)
+	layout, err := getWorkItemTypeLayout(clients, d)
+	if err != nil {
+		return err
+	}
+
+	// page and section are unknown after an import, so the group is looked up in the whole layout
+	pageID, sectionID, group := selectLayoutGroup(layout, d.Get("page_id").(string), d.Get("section_id").(string), d.Id())
+	if group == nil {
+		return fmt.Errorf("Could not find group %s in the layout of work item type %s", d.Id(), d.Get("work_item_type_id").(string))
+	}
+
+	d.Set("page_id", pageID)
+	d.Set("section_id", sectionID)
+	flattenWorkItemTypeGroup(d, group)
+	return nil
+}
+
+func resourceWorkItemTypeGroupUpdate(d *schema.ResourceData, m interface{}) error {
+	clients := m.(*config.AggregatedClient)
+	processID, witRefName, err := parseWorkItemTypeScope(d)
+	if err != nil {
+		return err
+	}
+
+	if d.HasChange("label") || d.HasChange("visible") || d.HasChange("order") {
+		_, err = clients.WorkItemTrackingProcessClient.UpdateGroup(clients.Ctx, workitemtrackingprocess.UpdateGroupArgs{
+			ProcessId:  processID,
+			WitRefName: witRefName,
+			PageId:     converter.String(d.Get("page_id").(string)),
+			SectionId:  converter.String(d.Get("section_id").(string)),
+			GroupId:    converter.String(d.Id()),
+			Group:      expandWorkItemTypeGroup(d),
+		})
+		if err != nil {
+			return fmt.Errorf("Error updating group in the layout of work item type in Azure DevOps: %+v", err)
+		}
+	}
+
+	if d.HasChange("control") {
+		oldData, newData := d.GetChange("control")
+		oldControls := expandWorkItemTypeGroupControls(oldData.([]interface{}))
+		newControls := expandWorkItemTypeGroupControls(newData.([]interface{}))
+
+		oldControlIDs := map[string]bool{}
+		for _, control := range oldControls {
+			oldControlIDs[*control.Id] = true
+		}
+		newControlIDs := map[string]bool{}
+		for _, control := range newControls {
+			newControlIDs[*control.Id] = true
+		}
+
+		for _, control := range oldControls {
+			if !newControlIDs[*control.Id] {
+				if err := removeWorkItemTypeGroupControl(clients, d, *control.Id); err != nil {
+					return err
+				}
+			}
+		}
+		// existing controls are updated as well, as their order changes if controls are inserted or moved
+		for _, control := range newControls {
+			if !oldControlIDs[*control.Id] {
+				err = addWorkItemTypeGroupControl(clients, d, control)
+			} else {
+				err = updateWorkItemTypeGroupControl(clients, d, control)
+			}
+			if err != nil {
+				return err
+			}
+		}
+	}
+
+	return resourceWorkItemTypeGroupRead(d, m)
+}
+
+func resourceWorkItemTypeGroupDelete(d *schema.ResourceData, m interface{}) error {
+	clients := m.(*config.AggregatedClient)
+	processID, witRefName, err := parseWorkItemTypeScope(d)
+	if err != nil {
+		return err
+	}
+
+	return clients.WorkItemTrackingProcessClient.RemoveGroup(clients.Ctx, workitemtrackingprocess.RemoveGroupArgs{
+		ProcessId:  processID,
+		WitRefName: witRefName,
+		PageId:     converter.String(d.Get("page_id").(string)),
+		SectionId:  converter.String(d.Get("section_id").(string)),
+		GroupId:    converter.String(d.Id()),
+	})
+}
+
+func getWorkItemTypeLayout(clients *config.AggregatedClient, d *schema.ResourceData) (*workitemtrackingprocess.FormLayout, error) {
+	processID, witRefName, err := parseWorkItemTypeScope(d)
+	if err != nil {
+		return nil, err
+	}
+
+	layout, err := clients.WorkItemTrackingProcessClient.GetFormLayout(clients.Ctx, workitemtrackingprocess.GetFormLayoutArgs{
+		ProcessId:  processID,
+		WitRefName: witRefName,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("Error looking up the layout of work item type %s. Error: %v", *witRefName, err)
+	}
+	return layout, nil
+}
+
+func addWorkItemTypeGroupControl(clients *config.AggregatedClient, d *schema.ResourceData, control workitemtrackingprocess.Control) error {
+	processID, witRefName, err := parseWorkItemTypeScope(d)
+	if err != nil {
+		return err
+	}
+
+	_, err = clients.WorkItemTrackingProcessClient.CreateControlInGroup(clients.Ctx, workitemtrackingprocess.CreateControlInGroupArgs{
+		ProcessId:  processID,
+		WitRefName: witRefName,
+		GroupId:    converter.String(d.Id()),
+		Control:    &control,
+	})
+	if err != nil {
+		return fmt.Errorf("Error adding control %s to group %s: %+v", *control.Id, d.Id(), err)
+	}
+	return nil
+}
+
+func updateWorkItemTypeGroupControl(clients *config.AggregatedClient, d *schema.ResourceData, control workitemtrackingprocess.Control) error {
+	processID, witRefName, err := parseWorkItemTypeScope(d)
+	if err != nil {
+		return err
+	}
+
+	_, err = clients.WorkItemTrackingProcessClient.UpdateControl(clients.Ctx, workitemtrackingprocess.UpdateControlArgs{
+		ProcessId:  processID,
+		WitRefName: witRefName,
+		GroupId:    converter.String(d.Id()),
+		ControlId:  control.Id,
+		Control:    &control,
+	})
+	if err != nil {
+		return fmt.Errorf("Error updating control %s of group %s: %+v", *control.Id, d.Id(), err)
+	}
+	return nil
+}
+
+func removeWorkItemTypeGroupControl(clients *config.AggregatedClient, d *schema.ResourceData, controlID string) error {
+	processID, witRefName, err := parseWorkItemTypeScope(d)
+	if err != nil {
+		return err
+	}
+
+	err = clients.WorkItemTrackingProcessClient.RemoveControlFromGroup(clients.Ctx, workitemtrackingprocess.RemoveControlFromGroupArgs{
+		ProcessId:  processID,
+		WitRefName: witRefName,
+		GroupId:    converter.String(d.Id()),
+		ControlId:  &controlID,
+	})
+	if err != nil {
+		return fmt.Errorf("Error removing control %s from group %s: %+v", controlID, d.Id(), err)
+	}
+	return nil
+}
+
+// The first custom page of a layout is the "Details" page, which is where groups are added by default
+func selectDefaultLayoutPage(layout *workitemtrackingprocess.FormLayout) *workitemtrackingprocess.Page {
+	if layout.Pages == nil {
+		return nil
+	}
+	for _, page := range *layout.Pages {
+		if page.PageType != nil && *page.PageType == workitemtrackingprocess.PageTypeValues.Custom {
+			return &page
+		}
+	}
+	return nil
+}
+
+// Looks up a group and the page and section it belongs to. An empty page or section ID matches any page or section.
+func selectLayoutGroup(layout *workitemtrackingprocess.FormLayout, pageID string, sectionID string, groupID string) (string, string, *workitemtrackingprocess.Group) {
+	if layout.Pages == nil {
+		return "", "", nil
+	}
+	for _, page := range *layout.Pages {
+		if page.Id == nil || (pageID != "" && *page.Id != pageID) || page.Sections == nil {
+			continue
+		}
+		for _, section := range *page.Sections {
+			if section.Id == nil || (sectionID != "" && *section.Id != sectionID) || section.Groups == nil {
+				continue
+			}
+			for _, group := range *section.Groups {
+				if group.Id != nil && *group.Id == groupID {
+					return *page.Id, *section.Id, &group
+				}
+			}
+		}
+	}
+	return "", "", nil
+}
+
+// Convert internal Terraform data structure to an AzDO data structure
+func expandWorkItemTypeGroup(d *schema.ResourceData) *workitemtrackingprocess.Group {
+	group := &workitemtrackingprocess.Group{
+		Label:   converter.String(d.Get("label").(string)),
+		Visible: converter.Bool(d.Get("visible").(bool)),
+	}
+	if order, ok := d.GetOk("order"); ok {
+		group.Order = converter.Int(order.(int))
+	}
+	return group
+}
+
+// Convert the configured controls into AzDO controls. The order of a control is its index in the configuration, so
+// that the controls are shown in the configured order.
+func expandWorkItemTypeGroupControls(rawControls []interface{}) []workitemtrackingprocess.Control {
+	controls := make([]workitemtrackingprocess.Control, 0, len(rawControls))
+	for i, raw := range rawControls {
+		control := raw.(map[string]interface{})
+		controls = append(controls, workitemtrackingprocess.Control{
+			Id:       converter.String(control["id"].(string)),
+			Label:    converter.String(control["label"].(string)),
+			ReadOnly: converter.Bool(control["read_only"].(bool)),
+			Visible:  converter.Bool(control["visible"].(bool)),
+			Order:    converter.Int(i),
+		})
+	}
+	return controls
+}
+
+func flattenWorkItemTypeGroup(d *schema.ResourceData, group *workitemtrackingprocess.Group) {
+	d.SetId(*group.Id)
+	d.Set("label", converter.ToString(group.Label, ""))
+	d.Set("visible", converter.ToBool(group.Visible, true))
+	if group.Order != nil {
+		d.Set("order", *group.Order)
+	}
+
+	controls := make([]map[string]interface{}, 0)
+	if group.Controls != nil {
+		for _, control := range *group.Controls {
+			controls = append(controls, map[string]interface{}{
+				"id":        converter.ToString(control.Id, ""),
+				"label":     converter.ToString(control.Label, ""),
+				"read_only": converter.ToBool(control.ReadOnly, false),
+				"visible":   converter.ToBool(control.Visible, true),
+			})
+		}
+	}
+	d.Set("control", controls)
+}
@@ -0,0 +1,289 @@
+// +build all resource_workitemtype_group
+
+package azuredevops
+
+// The tests in this file use the mock clients in mock_client.go to mock out
+// the Azure DevOps client operations.
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/google/uuid"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/terraform"
+	"github.com/microsoft/azure-devops-go-api/azuredevops/workitemtrackingprocess"
+	"github.com/microsoft/terraform-provider-azuredevops/azdosdkmocks"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/utils/config"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/utils/converter"
+	"github.com/stretchr/testify/require"
+)
+
+var testWorkItemTypeGroupProcessID = uuid.New()
+
+var testWorkItemTypeGroup = workitemtrackingprocess.Group{
+	Id:      converter.String("Group.CustomerImpact"),
+	Label:   converter.String("Customer"),
+	Visible: converter.Bool(true),
+	Order:   converter.Int(1),
+	Controls: &[]workitemtrackingprocess.Control{
+		{
+			Id:       converter.String("Custom.CustomerImpact"),
+			Label:    converter.String("Impact"),
+			ReadOnly: converter.Bool(false),
+			Visible:  converter.Bool(true),
+			Order:    converter.Int(0),
+		},
+	},
+}
+
+var testWorkItemTypeLayout = workitemtrackingprocess.FormLayout{
+	Pages: &[]workitemtrackingprocess.Page{
+		{
+			Id:       converter.String("History"),
+			PageType: &workitemtrackingprocess.PageTypeValues.History,
+		},
+		{
+			Id:       converter.String("Details"),
+			PageType: &workitemtrackingprocess.PageTypeValues.Custom,
+			Sections: &[]workitemtrackingprocess.Section{
+				{
+					Id:     converter.String("Section2"),
+					Groups: &[]workitemtrackingprocess.Group{testWorkItemTypeGroup},
+				},
+			},
+		},
+	},
+}
+
+func getWorkItemTypeGroupResourceData(t *testing.T) *schema.ResourceData {
+	resourceData := schema.TestResourceDataRaw(t, resourceWorkItemTypeGroup().Schema, nil)
+	resourceData.Set("process_id", testWorkItemTypeGroupProcessID.String())
+	resourceData.Set("work_item_type_id", "MyAgile.CustomerRequest")
+	resourceData.Set("page_id", "Details")
+	resourceData.Set("section_id", "Section2")
+	resourceData.SetId(*testWorkItemTypeGroup.Id)
+	return resourceData
+}
+
+/**
+ * Begin unit tests
+ */
+
+// verifies that the default page of a layout is the first custom page
+func TestAzureDevOpsWorkItemTypeGroup_SelectDefaultLayoutPage(t *testing.T) {
+	page := selectDefaultLayoutPage(&testWorkItemTypeLayout)
+	require.NotNil(t, page)
+	require.Equal(t, "Details", *page.Id)
+
+	require.Nil(t, selectDefaultLayoutPage(&workitemtrackingprocess.FormLayout{}))
+}
+
+// verifies that the read operation finds the group in the layout and flattens its controls
+func TestAzureDevOpsWorkItemTypeGroup_Read_FindsGroupInLayout(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	processClient := azdosdkmocks.NewMockWorkitemtrackingprocessClient(ctrl)
+	clients := &config.AggregatedClient{
+		WorkItemTrackingProcessClient: processClient,
+		Ctx:                           context.Background(),
+	}
+
+	resourceData := getWorkItemTypeGroupResourceData(t)
+
+	processClient.
+		EXPECT().
+		GetFormLayout(clients.Ctx, workitemtrackingprocess.GetFormLayoutArgs{
+			ProcessId:  &testWorkItemTypeGroupProcessID,
+			WitRefName: converter.String("MyAgile.CustomerRequest"),
+		}).
+		Return(&testWorkItemTypeLayout, nil).
+		Times(1)
+
+	err := resourceWorkItemTypeGroupRead(resourceData, clients)
+	require.Nil(t, err)
+	require.Equal(t, "Customer", resourceData.Get("label"))
+	require.Equal(t, 1, resourceData.Get("control.#"))
+	require.Equal(t, "Custom.CustomerImpact", resourceData.Get("control.0.id"))
+
+	controls := expandWorkItemTypeGroupControls(resourceData.Get("control").([]interface{}))
+	require.Equal(t, *testWorkItemTypeGroup.Controls, controls)
+}
+
+// verifies that an imported group is looked up in the whole layout and that its page and section are read
+func TestAzureDevOpsWorkItemTypeGroup_Import_ReadsPageAndSection(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	processClient := azdosdkmocks.NewMockWorkitemtrackingprocessClient(ctrl)
+	clients := &config.AggregatedClient{
+		WorkItemTrackingProcessClient: processClient,
+		Ctx:                           context.Background(),
+	}
+
+	// imported resources start without any attributes, so no defaults are applied
+	resourceData := resourceWorkItemTypeGroup().Data(&terraform.InstanceState{
+		ID: testWorkItemTypeGroupProcessID.String() + "/MyAgile.CustomerRequest/" + *testWorkItemTypeGroup.Id,
+	})
+	_, err := importWorkItemTypeChild(resourceData, clients)
+	require.Nil(t, err)
+
+	processClient.
+		EXPECT().
+		GetFormLayout(clients.Ctx, gomock.Any()).
+		Return(&testWorkItemTypeLayout, nil).
+		Times(1)
+
+	err = resourceWorkItemTypeGroupRead(resourceData, clients)
+	require.Nil(t, err)
+	require.Equal(t, "Details", resourceData.Get("page_id"))
+	require.Equal(t, "Section2", resourceData.Get("section_id"))
+	require.Equal(t, "Group.CustomerImpact", resourceData.Id())
+}
+
+// verifies that controls are expanded in the configured order
+func TestAzureDevOpsWorkItemTypeGroup_ExpandControls_KeepsOrder(t *testing.T) {
+	ids := []string{"System.Title", "Custom.CustomerImpact", "System.AreaPath", "Custom.Severity"}
+	rawControls := []interface{}{}
+	for _, id := range ids {
+		rawControls = append(rawControls, map[string]interface{}{
+			"id":        id,
+			"label":     "",
+			"read_only": false,
+			"visible":   true,
+		})
+	}
+
+	controls := expandWorkItemTypeGroupControls(rawControls)
+	require.Equal(t, len(ids), len(controls))
+	for i, id := range ids {
+		require.Equal(t, id, *controls[i].Id)
+		require.Equal(t, i, *controls[i].Order)
+	}
+}
+
+// verifies that a control inserted in the middle of the list is added at its position and that the order of the
+// controls after it is updated
+func TestAzureDevOpsWorkItemTypeGroup_Update_InsertsControlInOrder(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	processClient := azdosdkmocks.NewMockWorkitemtrackingprocessClient(ctrl)
+	clients := &config.AggregatedClient{
+		WorkItemTrackingProcessClient: processClient,
+		Ctx:                           context.Background(),
+	}
+
+	groupControl := func(id string) map[string]interface{} {
+		return map[string]interface{}{"id": id, "label": "", "read_only": false, "visible": true}
+	}
+	groupConfig := map[string]interface{}{
+		"process_id":        testWorkItemTypeGroupProcessID.String(),
+		"work_item_type_id": "MyAgile.CustomerRequest",
+		"page_id":           "Details",
+		"section_id":        "Section2",
+		"label":             "Customer",
+		"control":           []interface{}{groupControl("System.Title"), groupControl("Custom.Severity")},
+	}
+
+	r := resourceWorkItemTypeGroup()
+	stateData := schema.TestResourceDataRaw(t, r.Schema, groupConfig)
+	stateData.SetId(*testWorkItemTypeGroup.Id)
+	state := stateData.State()
+
+	groupConfig["control"] = []interface{}{groupControl("System.Title"), groupControl("Custom.CustomerImpact"), groupControl("Custom.Severity")}
+	diff, err := r.Diff(state, terraform.NewResourceConfigRaw(groupConfig), nil)
+	require.Nil(t, err)
+	resourceData, err := schema.InternalMap(r.Schema).Data(state, diff)
+	require.Nil(t, err)
+
+	processClient.
+		EXPECT().
+		CreateControlInGroup(clients.Ctx, gomock.Any()).
+		DoAndReturn(func(ctx context.Context, args workitemtrackingprocess.CreateControlInGroupArgs) (*workitemtrackingprocess.Control, error) {
+			require.Equal(t, "Custom.CustomerImpact", *args.Control.Id)
+			require.Equal(t, 1, *args.Control.Order)
+			return args.Control, nil
+		}).
+		Times(1)
+	controlOrders := map[string]int{}
+	processClient.
+		EXPECT().
+		UpdateControl(clients.Ctx, gomock.Any()).
+		DoAndReturn(func(ctx context.Context, args workitemtrackingprocess.UpdateControlArgs) (*workitemtrackingprocess.Control, error) {
+			controlOrders[*args.ControlId] = *args.Control.Order
+			return args.Control, nil
+		}).
+		Times(2)
+	processClient.
+		EXPECT().
+		GetFormLayout(clients.Ctx, gomock.Any()).
+		Return(&testWorkItemTypeLayout, nil).
+		Times(1)
+
+	err = resourceWorkItemTypeGroupUpdate(resourceData, clients)
+	require.Nil(t, err)
+	require.Equal(t, map[string]int{"System.Title": 0, "Custom.Severity": 2}, controlOrders)
+}
+
+// verifies that the read operation fails if the group no longer exists in the layout
+func TestAzureDevOpsWorkItemTypeGroup_Read_ErrorsIfGroupIsMissing(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	processClient := azdosdkmocks.NewMockWorkitemtrackingprocessClient(ctrl)
+	clients := &config.AggregatedClient{
+		WorkItemTrackingProcessClient: processClient,
+		Ctx:                           context.Background(),
+	}
+
+	resourceData := getWorkItemTypeGroupResourceData(t)
+	resourceData.SetId("Group.Unknown")
+
+	processClient.
+		EXPECT().
+		GetFormLayout(clients.Ctx, gomock.Any()).
+		Return(&testWorkItemTypeLayout, nil).
+		Times(1)
+
+	err := resourceWorkItemTypeGroupRead(resourceData, clients)
+	require.NotNil(t, err)
+	require.Contains(t, err.Error(), "Could not find group Group.Unknown")
+}
+
+// verifies that the create operation is considered failed if the API call fails.
+func TestAzureDevOpsWorkItemTypeGroup_Create_DoesNotSwallowError(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	processClient := azdosdkmocks.NewMockWorkitemtrackingprocessClient(ctrl)
+	clients := &config.AggregatedClient{
+		WorkItemTrackingProcessClient: processClient,
+		Ctx:                           context.Background(),
+	}
+
+	resourceData := getWorkItemTypeGroupResourceData(t)
+	resourceData.Set("label", "Customer")
+	resourceData.SetId("")
+
+	processClient.
+		EXPECT().
+		AddGroup(clients.Ctx, workitemtrackingprocess.AddGroupArgs{
+			ProcessId:  &testWorkItemTypeGroupProcessID,
+			WitRefName: converter.String("MyAgile.CustomerRequest"),
+			PageId:     converter.String("Details"),
+			SectionId:  converter.String("Section2"),
+			Group: &workitemtrackingprocess.Group{
+				Label:   converter.String("Customer"),
+				Visible: converter.Bool(true),
+			},
+		}).
+		Return(nil, errors.New("AddGroup() Failed")).
+		Times(1)
+
+	err := resourceWorkItemTypeGroupCreate(resourceData, clients)
+	require.Contains(t, err.Error(), "AddGroup() Failed")
+}
@@ -0,0 +1,277 @@
+package azuredevops
+
+import (
+	"fmt"
+
+	"github.com/google/uuid"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/validation"
+	"github.com/microsoft/azure-devops-go-api/azuredevops/workitemtrackingprocess"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/utils/config"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/utils/converter"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/utils/validate"
+)
+
+func resourceWorkItemTypeRule() *schema.Resource {
+	s := workItemTypeChildSchema()
+	s["name"] = &schema.Schema{
+		Type:         schema.TypeString,
+		Required:     true,
+		ValidateFunc: validate.NoEmptyStrings,
+	}
+	s["is_disabled"] = &schema.Schema{
+		Type:     schema.TypeBool,
+		Optional: true,
+		Default:  false,
+	}
+	s["condition"] = &schema.Schema{
+		Type:     schema.TypeList,
+		Required: true,
+		MinItems: 1,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"condition_type": {
+					Type:     schema.TypeString,
+					Required: true,
+					ValidateFunc: validation.StringInSlice([]string{
+						string(workitemtrackingprocess.RuleConditionTypeValues.When),
+						string(workitemtrackingprocess.RuleConditionTypeValues.WhenNot),
+						string(workitemtrackingprocess.RuleConditionTypeValues.WhenChanged),
+						string(workitemtrackingprocess.RuleConditionTypeValues.WhenNotChanged),
+						string(workitemtrackingprocess.RuleConditionTypeValues.WhenWas),
+						string(workitemtrackingprocess.RuleConditionTypeValues.WhenStateChangedTo),
+						string(workitemtrackingprocess.RuleConditionTypeValues.WhenStateChangedFromAndTo),
+						string(workitemtrackingprocess.RuleConditionTypeValues.WhenWorkItemIsCreated),
+						string(workitemtrackingprocess.RuleConditionTypeValues.WhenValueIsDefined),
+						string(workitemtrackingprocess.RuleConditionTypeValues.WhenValueIsNotDefined),
+					}, false),
+				},
+				"field": {
+					Type:     schema.TypeString,
+					Optional: true,
+					Default:  "",
+				},
+				"value": {
+					Type:     schema.TypeString,
+					Optional: true,
+					Default:  "",
+				},
+			},
+		},
+	}
+	s["action"] = &schema.Schema{
+		Type:     schema.TypeList,
+		Required: true,
+		MinItems: 1,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"action_type": {
+					Type:     schema.TypeString,
+					Required: true,
+					ValidateFunc: validation.StringInSlice([]string{
+						string(workitemtrackingprocess.RuleActionTypeValues.MakeRequired),
+						string(workitemtrackingprocess.RuleActionTypeValues.MakeReadOnly),
+						string(workitemtrackingprocess.RuleActionTypeValues.SetDefaultValue),
+						string(workitemtrackingprocess.RuleActionTypeValues.SetDefaultFromClock),
+						string(workitemtrackingprocess.RuleActionTypeValues.SetDefaultFromCurrentUser),
+						string(workitemtrackingprocess.RuleActionTypeValues.SetDefaultFromField),
+						string(workitemtrackingprocess.RuleActionTypeValues.CopyValue),
+						string(workitemtrackingprocess.RuleActionTypeValues.CopyFromClock),
+						string(workitemtrackingprocess.RuleActionTypeValues.CopyFromCurrentUser),
+						string(workitemtrackingprocess.RuleActionTypeValues.CopyFromField),
+						string(workitemtrackingprocess.RuleActionTypeValues.SetValueToEmpty),
+						string(workitemtrackingprocess.RuleActionTypeValues.CopyFromServerClock),
+						string(workitemtrackingprocess.RuleActionTypeValues.CopyFromServerCurrentUser),
+					}, false),
+				},
+				"target_field": {
+					Type:         schema.TypeString,
+					Required:     true,
+					ValidateFunc: validate.NoEmptyStrings,
+				},
+				"value": {
+					Type:     schema.TypeString,
+					Optional: true,
+					Default:  "",
+				},
+			},
+		},
+	}
+
+	return &schema.Resource{
+		Create: resourceWorkItemTypeRuleCreate,
+		Read:   resourceWorkItemTypeRuleRead,
+		Update: resourceWorkItemTypeRuleUpdate,
+		Delete: resourceWorkItemTypeRuleDelete,
+		Importer: &schema.ResourceImporter{
+			State: importWorkItemTypeChild,
+		},
+		Schema: s,
+	}
+}
+
+func resourceWorkItemTypeRuleCreate(d *schema.ResourceData, m interface{}) error {
+	clients := m.(*config.AggregatedClient)
+	processID, witRefName, err := parseWorkItemTypeScope(d)
+	if err != nil {
+		return err
+	}
+
+	conditions, actions := expandWorkItemTypeRule(d)
+	rule, err := clients.WorkItemTrackingProcessClient.AddProcessWorkItemTypeRule(clients.Ctx, workitemtrackingprocess.AddProcessWorkItemTypeRuleArgs{
+		ProcessId:  processID,
+		WitRefName: witRefName,
+		ProcessRuleCreate: &workitemtrackingprocess.CreateProcessRuleRequest{
+			Name:       converter.String(d.Get("name").(string)),
+			IsDisabled: converter.Bool(d.Get("is_disabled").(bool)),
+			Conditions: conditions,
+			Actions:    actions,
+		},
+	})
+	if err != nil {
+		return fmt.Errorf("Error creating rule of work item type in Azure DevOps: %+v", err)
+	}
+
+	flattenWorkItemTypeRule(d, rule)
+	return resourceWorkItemTypeRuleRead(d, m)
+}
+
+func resourceWorkItemTypeRuleRead(d *schema.ResourceData, m interface{}) error {
+	clients := m.(*config.AggregatedClient)
+	processID, witRefName, err := parseWorkItemTypeScope(d)
+	if err != nil {
+		return err
+	}
+	ruleID, err := uuid.Parse(d.Id())
+	if err != nil {
+		return fmt.Errorf("Invalid rule UUID: %s", d.Id())
+	}
+
+	rule, err := clients.WorkItemTrackingProcessClient.GetProcessWorkItemTypeRule(clients.Ctx, workitemtrackingprocess.GetProcessWorkItemTypeRuleArgs{
+		ProcessId:  processID,
+		WitRefName: witRefName,
+		RuleId:     &ruleID,
+	})
+	if err != nil {
+		return fmt.Errorf("Error looking up rule %s of work item type %s. Error: %v", d.Id(), *witRefName, err)
+	}
+
+	flattenWorkItemTypeRule(d, rule)
+	return nil
+}
+
+func resourceWorkItemTypeRuleUpdate(d *schema.ResourceData, m interface{}) error {
+	clients := m.(*config.AggregatedClient)
+	processID, witRefName, err := parseWorkItemTypeScope(d)
+	if err != nil {
+		return err
+	}
+	ruleID, err := uuid.Parse(d.Id())
+	if err != nil {
+		return fmt.Errorf("Invalid rule UUID: %s", d.Id())
+	}
+
+	conditions, actions := expandWorkItemTypeRule(d)
+	_, err = clients.WorkItemTrackingProcessClient.UpdateProcessWorkItemTypeRule(clients.Ctx, workitemtrackingprocess.UpdateProcessWorkItemTypeRuleArgs{
+		ProcessId:  processID,
+		WitRefName: witRefName,
+		RuleId:     &ruleID,
+		ProcessRule: &workitemtrackingprocess.UpdateProcessRuleRequest{
+			Id:         &ruleID,
+			Name:       converter.String(d.Get("name").(string)),
+			IsDisabled: converter.Bool(d.Get("is_disabled").(bool)),
+			Conditions: conditions,
+			Actions:    actions,
+		},
+	})
+	if err != nil {
+		return fmt.Errorf("Error updating rule of work item type in Azure DevOps: %+v", err)
+	}
+
+	return resourceWorkItemTypeRuleRead(d, m)
+}
+
+func resourceWorkItemTypeRuleDelete(d *schema.ResourceData, m interface{}) error {
+	clients := m.(*config.AggregatedClient)
+	processID, witRefName, err := parseWorkItemTypeScope(d)
+	if err != nil {
+		return err
+	}
+	ruleID, err := uuid.Parse(d.Id())
+	if err != nil {
+		return fmt.Errorf("Invalid rule UUID: %s", d.Id())
+	}
+
+	return clients.WorkItemTrackingProcessClient.DeleteProcessWorkItemTypeRule(clients.Ctx, workitemtrackingprocess.DeleteProcessWorkItemTypeRuleArgs{
+		ProcessId:  processID,
+		WitRefName: witRefName,
+		RuleId:     &ruleID,
+	})
+}
+
+// Convert internal Terraform data structure to an AzDO data structure
+func expandWorkItemTypeRule(d *schema.ResourceData) (*[]workitemtrackingprocess.RuleCondition, *[]workitemtrackingprocess.RuleAction) {
+	rawConditions := d.Get("condition").([]interface{})
+	conditions := make([]workitemtrackingprocess.RuleCondition, len(rawConditions))
+	for i, raw := range rawConditions {
+		condition := raw.(map[string]interface{})
+		conditionType := workitemtrackingprocess.RuleConditionType(condition["condition_type"].(string))
+		conditions[i] = workitemtrackingprocess.RuleCondition{
+			ConditionType: &conditionType,
+			Field:         converter.String(condition["field"].(string)),
+			Value:         converter.String(condition["value"].(string)),
+		}
+	}
+
+	rawActions := d.Get("action").([]interface{})
+	actions := make([]workitemtrackingprocess.RuleAction, len(rawActions))
+	for i, raw := range rawActions {
+		action := raw.(map[string]interface{})
+		actionType := workitemtrackingprocess.RuleActionType(action["action_type"].(string))
+		actions[i] = workitemtrackingprocess.RuleAction{
+			ActionType:  &actionType,
+			TargetField: converter.String(action["target_field"].(string)),
+			Value:       converter.String(action["value"].(string)),
+		}
+	}
+
+	return &conditions, &actions
+}
+
+func flattenWorkItemTypeRule(d *schema.ResourceData, rule *workitemtrackingprocess.ProcessRule) {
+	d.SetId(rule.Id.String())
+	d.Set("name", converter.ToString(rule.Name, ""))
+	d.Set("is_disabled", converter.ToBool(rule.IsDisabled, false))
+
+	conditions := make([]map[string]interface{}, 0)
+	if rule.Conditions != nil {
+		for _, condition := range *rule.Conditions {
+			conditionType := ""
+			if condition.ConditionType != nil {
+				conditionType = string(*condition.ConditionType)
+			}
+			conditions = append(conditions, map[string]interface{}{
+				"condition_type": conditionType,
+				"field":          converter.ToString(condition.Field, ""),
+				"value":          converter.ToString(condition.Value, ""),
+			})
+		}
+	}
+	d.Set("condition", conditions)
+
+	actions := make([]map[string]interface{}, 0)
+	if rule.Actions != nil {
+		for _, action := range *rule.Actions {
+			actionType := ""
+			if action.ActionType != nil {
+				actionType = string(*action.ActionType)
+			}
+			actions = append(actions, map[string]interface{}{
+				"action_type":  actionType,
+				"target_field": converter.ToString(action.TargetField, ""),
+				"value":        converter.ToString(action.Value, ""),
+			})
+		}
+	}
+	d.Set("action", actions)
+}
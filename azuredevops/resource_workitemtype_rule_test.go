@@ -0,0 +1,105 @@
+// +build all resource_workitemtype_rule
+
+package azuredevops
+
+// The tests in this file use the mock clients in mock_client.go to mock out
+// the Azure DevOps client operations.
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/google/uuid"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/microsoft/azure-devops-go-api/azuredevops/workitemtrackingprocess"
+	"github.com/microsoft/terraform-provider-azuredevops/azdosdkmocks"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/utils/config"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/utils/converter"
+	"github.com/stretchr/testify/require"
+)
+
+var testWorkItemTypeRuleProcessID = uuid.New()
+var testWorkItemTypeRuleID = uuid.New()
+
+var testWorkItemTypeRule = workitemtrackingprocess.ProcessRule{
+	Id:         &testWorkItemTypeRuleID,
+	Name:       converter.String("Impact is required when triaged"),
+	IsDisabled: converter.Bool(false),
+	Conditions: &[]workitemtrackingprocess.RuleCondition{
+		{
+			ConditionType: &workitemtrackingprocess.RuleConditionTypeValues.WhenStateChangedTo,
+			Value:         converter.String("Triaged"),
+		},
+	},
+	Actions: &[]workitemtrackingprocess.RuleAction{
+		{
+			ActionType:  &workitemtrackingprocess.RuleActionTypeValues.MakeRequired,
+			TargetField: converter.String("Custom.CustomerImpact"),
+		},
+	},
+}
+
+/**
+ * Begin unit tests
+ */
+
+// verifies that the flatten/expand round trip yields the same conditions and actions
+func TestAzureDevOpsWorkItemTypeRule_ExpandFlatten_Roundtrip(t *testing.T) {
+	resourceData := schema.TestResourceDataRaw(t, resourceWorkItemTypeRule().Schema, nil)
+	flattenWorkItemTypeRule(resourceData, &testWorkItemTypeRule)
+
+	conditions, actions := expandWorkItemTypeRule(resourceData)
+	require.Equal(t, *testWorkItemTypeRule.Conditions, *conditions)
+	require.Equal(t, *testWorkItemTypeRule.Actions, *actions)
+	require.Equal(t, testWorkItemTypeRuleID.String(), resourceData.Id())
+}
+
+// verifies that the update operation addresses the rule by its ID and does not swallow errors
+func TestAzureDevOpsWorkItemTypeRule_Update_DoesNotSwallowError(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	processClient := azdosdkmocks.NewMockWorkitemtrackingprocessClient(ctrl)
+	clients := &config.AggregatedClient{
+		WorkItemTrackingProcessClient: processClient,
+		Ctx:                           context.Background(),
+	}
+
+	resourceData := schema.TestResourceDataRaw(t, resourceWorkItemTypeRule().Schema, nil)
+	resourceData.Set("process_id", testWorkItemTypeRuleProcessID.String())
+	resourceData.Set("work_item_type_id", "MyAgile.CustomerRequest")
+	flattenWorkItemTypeRule(resourceData, &testWorkItemTypeRule)
+
+	processClient.
+		EXPECT().
+		UpdateProcessWorkItemTypeRule(clients.Ctx, workitemtrackingprocess.UpdateProcessWorkItemTypeRuleArgs{
+			ProcessId:  &testWorkItemTypeRuleProcessID,
+			WitRefName: converter.String("MyAgile.CustomerRequest"),
+			RuleId:     &testWorkItemTypeRuleID,
+			ProcessRule: &workitemtrackingprocess.UpdateProcessRuleRequest{
+				Id:         &testWorkItemTypeRuleID,
+				Name:       testWorkItemTypeRule.Name,
+				IsDisabled: testWorkItemTypeRule.IsDisabled,
+				Conditions: testWorkItemTypeRule.Conditions,
+				Actions:    testWorkItemTypeRule.Actions,
+			},
+		}).
+		Return(nil, errors.New("UpdateProcessWorkItemTypeRule() Failed")).
+		Times(1)
+
+	err := resourceWorkItemTypeRuleUpdate(resourceData, clients)
+	require.Contains(t, err.Error(), "UpdateProcessWorkItemTypeRule() Failed")
+}
+
+// validates that unknown condition types are rejected by the schema
+func TestAzureDevOpsWorkItemTypeRule_ConditionType_RejectsUnknownValues(t *testing.T) {
+	conditionSchema := resourceWorkItemTypeRule().Schema["condition"].Elem.(*schema.Resource).Schema["condition_type"]
+
+	_, errors := conditionSchema.ValidateFunc("whenStateChangedTo", "condition_type")
+	require.Equal(t, 0, len(errors))
+
+	_, errors = conditionSchema.ValidateFunc("whenever", "condition_type")
+	require.Equal(t, 1, len(errors))
+}
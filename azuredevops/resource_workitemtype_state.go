@@ -0,0 +1,159 @@
+package azuredevops
+
+import (
+	"fmt"
+
+	"github.com/google/uuid"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/validation"
+	"github.com/microsoft/azure-devops-go-api/azuredevops/workitemtrackingprocess"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/utils/config"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/utils/converter"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/utils/validate"
+)
+
+func resourceWorkItemTypeState() *schema.Resource {
+	s := workItemTypeChildSchema()
+	s["name"] = &schema.Schema{
+		Type:         schema.TypeString,
+		Required:     true,
+		ForceNew:     true,
+		ValidateFunc: validate.NoEmptyStrings,
+	}
+	s["color"] = &schema.Schema{
+		Type:         schema.TypeString,
+		Required:     true,
+		ValidateFunc: validate.NoEmptyStrings,
+	}
+	s["state_category"] = &schema.Schema{
+		Type:         schema.TypeString,
+		Required:     true,
+		ForceNew:     true,
+		ValidateFunc: validation.StringInSlice([]string{"Proposed", "InProgress", "Resolved", "Completed", "Removed"}, false),
+	}
+	s["order"] = &schema.Schema{
+		Type:     schema.TypeInt,
+		Optional: true,
+		Computed: true,
+	}
+
+	return &schema.Resource{
+		Create: resourceWorkItemTypeStateCreate,
+		Read:   resourceWorkItemTypeStateRead,
+		Update: resourceWorkItemTypeStateUpdate,
+		Delete: resourceWorkItemTypeStateDelete,
+		Importer: &schema.ResourceImporter{
+			State: importWorkItemTypeChild,
+		},
+		Schema: s,
+	}
+}
+
+func resourceWorkItemTypeStateCreate(d *schema.ResourceData, m interface{}) error {
+	clients := m.(*config.AggregatedClient)
+	processID, witRefName, err := parseWorkItemTypeScope(d)
+	if err != nil {
+		return err
+	}
+
+	state, err := clients.WorkItemTrackingProcessClient.CreateStateDefinition(clients.Ctx, workitemtrackingprocess.CreateStateDefinitionArgs{
+		ProcessId:  processID,
+		WitRefName: witRefName,
+		StateModel: expandWorkItemTypeState(d),
+	})
+	if err != nil {
+		return fmt.Errorf("Error creating state of work item type in Azure DevOps: %+v", err)
+	}
+
+	flattenWorkItemTypeState(d, state)
+	return resourceWorkItemTypeStateRead(d, m)
+}
+
+func resourceWorkItemTypeStateRead(d *schema.ResourceData, m interface{}) error {
+	clients := m.(*config.AggregatedClient)
+	processID, witRefName, err := parseWorkItemTypeScope(d)
+	if err != nil {
+		return err
+	}
+	stateID, err := uuid.Parse(d.Id())
+	if err != nil {
+		return fmt.Errorf("Invalid state UUID: %s", d.Id())
+	}
+
+	state, err := clients.WorkItemTrackingProcessClient.GetStateDefinition(clients.Ctx, workitemtrackingprocess.GetStateDefinitionArgs{
+		ProcessId:  processID,
+		WitRefName: witRefName,
+		StateId:    &stateID,
+	})
+	if err != nil {
+		return fmt.Errorf("Error looking up state %s of work item type %s. Error: %v", d.Id(), *witRefName, err)
+	}
+
+	flattenWorkItemTypeState(d, state)
+	return nil
+}
+
+func resourceWorkItemTypeStateUpdate(d *schema.ResourceData, m interface{}) error {
+	clients := m.(*config.AggregatedClient)
+	processID, witRefName, err := parseWorkItemTypeScope(d)
+	if err != nil {
+		return err
+	}
+	stateID, err := uuid.Parse(d.Id())
+	if err != nil {
+		return fmt.Errorf("Invalid state UUID: %s", d.Id())
+	}
+
+	_, err = clients.WorkItemTrackingProcessClient.UpdateStateDefinition(clients.Ctx, workitemtrackingprocess.UpdateStateDefinitionArgs{
+		ProcessId:  processID,
+		WitRefName: witRefName,
+		StateId:    &stateID,
+		StateModel: expandWorkItemTypeState(d),
+	})
+	if err != nil {
+		return fmt.Errorf("Error updating state of work item type in Azure DevOps: %+v", err)
+	}
+
+	return resourceWorkItemTypeStateRead(d, m)
+}
+
+func resourceWorkItemTypeStateDelete(d *schema.ResourceData, m interface{}) error {
+	clients := m.(*config.AggregatedClient)
+	processID, witRefName, err := parseWorkItemTypeScope(d)
+	if err != nil {
+		return err
+	}
+	stateID, err := uuid.Parse(d.Id())
+	if err != nil {
+		return fmt.Errorf("Invalid state UUID: %s", d.Id())
+	}
+
+	return clients.WorkItemTrackingProcessClient.DeleteStateDefinition(clients.Ctx, workitemtrackingprocess.DeleteStateDefinitionArgs{
+		ProcessId:  processID,
+		WitRefName: witRefName,
+		StateId:    &stateID,
+	})
+}
+
+// Convert internal Terraform data structure to an AzDO data structure
+func expandWorkItemTypeState(d *schema.ResourceData) *workitemtrackingprocess.WorkItemStateInputModel {
+	state := &workitemtrackingprocess.WorkItemStateInputModel{
+		Name:          converter.String(d.Get("name").(string)),
+		Color:         converter.String(d.Get("color").(string)),
+		StateCategory: converter.String(d.Get("state_category").(string)),
+	}
+	if order, ok := d.GetOk("order"); ok {
+		state.Order = converter.Int(order.(int))
+	}
+	return state
+}
+
+func flattenWorkItemTypeState(d *schema.ResourceData, state *workitemtrackingprocess.WorkItemStateResultModel) {
+	d.SetId(state.Id.String())
+	d.Set("name", converter.ToString(state.Name, ""))
+	d.Set("color", converter.ToString(state.Color, ""))
+	d.Set("state_category", converter.ToString(state.StateCategory, ""))
+	if state.Order != nil {
+		d.Set("order", *state.Order)
+	}
+}
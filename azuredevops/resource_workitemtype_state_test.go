@@ -0,0 +1,96 @@
+// +build all resource_workitemtype_state
+
+package azuredevops
+
+// The tests in this file use the mock clients in mock_client.go to mock out
+// the Azure DevOps client operations.
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/google/uuid"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/microsoft/azure-devops-go-api/azuredevops/workitemtrackingprocess"
+	"github.com/microsoft/terraform-provider-azuredevops/azdosdkmocks"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/utils/config"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/utils/converter"
+	"github.com/stretchr/testify/require"
+)
+
+var testWorkItemTypeStateProcessID = uuid.New()
+var testWorkItemTypeStateID = uuid.New()
+
+var testWorkItemTypeState = workitemtrackingprocess.WorkItemStateResultModel{
+	Id:            &testWorkItemTypeStateID,
+	Name:          converter.String("Triaged"),
+	Color:         converter.String("b2b2b2"),
+	StateCategory: converter.String("Proposed"),
+	Order:         converter.Int(2),
+}
+
+/**
+ * Begin unit tests
+ */
+
+// verifies that the flatten/expand round trip yields the same state
+func TestAzureDevOpsWorkItemTypeState_ExpandFlatten_Roundtrip(t *testing.T) {
+	resourceData := schema.TestResourceDataRaw(t, resourceWorkItemTypeState().Schema, nil)
+	flattenWorkItemTypeState(resourceData, &testWorkItemTypeState)
+
+	state := expandWorkItemTypeState(resourceData)
+	require.Equal(t, testWorkItemTypeState.Name, state.Name)
+	require.Equal(t, testWorkItemTypeState.Color, state.Color)
+	require.Equal(t, testWorkItemTypeState.StateCategory, state.StateCategory)
+	require.Equal(t, testWorkItemTypeState.Order, state.Order)
+	require.Equal(t, testWorkItemTypeStateID.String(), resourceData.Id())
+}
+
+// verifies that the create operation is considered failed if the API call fails.
+func TestAzureDevOpsWorkItemTypeState_Create_DoesNotSwallowError(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	processClient := azdosdkmocks.NewMockWorkitemtrackingprocessClient(ctrl)
+	clients := &config.AggregatedClient{
+		WorkItemTrackingProcessClient: processClient,
+		Ctx:                           context.Background(),
+	}
+
+	resourceData := schema.TestResourceDataRaw(t, resourceWorkItemTypeState().Schema, nil)
+	resourceData.Set("process_id", testWorkItemTypeStateProcessID.String())
+	resourceData.Set("work_item_type_id", "MyAgile.CustomerRequest")
+	flattenWorkItemTypeState(resourceData, &testWorkItemTypeState)
+	resourceData.SetId("")
+
+	processClient.
+		EXPECT().
+		CreateStateDefinition(clients.Ctx, workitemtrackingprocess.CreateStateDefinitionArgs{
+			ProcessId:  &testWorkItemTypeStateProcessID,
+			WitRefName: converter.String("MyAgile.CustomerRequest"),
+			StateModel: &workitemtrackingprocess.WorkItemStateInputModel{
+				Name:          testWorkItemTypeState.Name,
+				Color:         testWorkItemTypeState.Color,
+				StateCategory: testWorkItemTypeState.StateCategory,
+				Order:         testWorkItemTypeState.Order,
+			},
+		}).
+		Return(nil, errors.New("CreateStateDefinition() Failed")).
+		Times(1)
+
+	err := resourceWorkItemTypeStateCreate(resourceData, clients)
+	require.Contains(t, err.Error(), "CreateStateDefinition() Failed")
+}
+
+func TestAzureDevOpsWorkItemTypeState_Delete_ChecksForValidUUID(t *testing.T) {
+	resourceData := schema.TestResourceDataRaw(t, resourceWorkItemTypeState().Schema, nil)
+	resourceData.Set("process_id", testWorkItemTypeStateProcessID.String())
+	resourceData.Set("work_item_type_id", "MyAgile.CustomerRequest")
+	resourceData.SetId("not-a-uuid-id")
+
+	err := resourceWorkItemTypeStateDelete(resourceData, &config.AggregatedClient{})
+	require.NotNil(t, err)
+	require.Contains(t, err.Error(), "Invalid state UUID")
+}
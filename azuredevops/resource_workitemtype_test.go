@@ -0,0 +1,221 @@
+// +build all resource_workitemtype
+
+package azuredevops
+
+// The tests in this file use the mock clients in mock_client.go to mock out
+// the Azure DevOps client operations.
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/google/uuid"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/terraform"
+	"github.com/microsoft/azure-devops-go-api/azuredevops/workitemtrackingprocess"
+	"github.com/microsoft/terraform-provider-azuredevops/azdosdkmocks"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/utils/config"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/utils/converter"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/utils/testhelper"
+	"github.com/stretchr/testify/require"
+)
+
+var testWorkItemTypeProcessID = uuid.New()
+
+var testWorkItemType = workitemtrackingprocess.ProcessWorkItemType{
+	Name:          converter.String("Customer Request"),
+	ReferenceName: converter.String("MyAgile.CustomerRequest"),
+	Description:   converter.String("A request raised by a customer"),
+	Color:         converter.String("f6546a"),
+	Icon:          converter.String("icon_chat_bubble"),
+	IsDisabled:    converter.Bool(false),
+}
+
+/**
+ * Begin unit tests
+ */
+
+// verifies that the create operation sends the configured work item type and does not swallow errors
+func TestAzureDevOpsWorkItemType_Create_DoesNotSwallowError(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	processClient := azdosdkmocks.NewMockWorkitemtrackingprocessClient(ctrl)
+	clients := &config.AggregatedClient{
+		WorkItemTrackingProcessClient: processClient,
+		Ctx:                           context.Background(),
+	}
+
+	resourceData := schema.TestResourceDataRaw(t, resourceWorkItemType().Schema, nil)
+	resourceData.Set("process_id", testWorkItemTypeProcessID.String())
+	flattenWorkItemType(resourceData, &testWorkItemType)
+	resourceData.SetId("")
+
+	expectedArgs := workitemtrackingprocess.CreateProcessWorkItemTypeArgs{
+		ProcessId: &testWorkItemTypeProcessID,
+		WorkItemType: &workitemtrackingprocess.CreateProcessWorkItemTypeRequest{
+			Name:        testWorkItemType.Name,
+			Description: testWorkItemType.Description,
+			Color:       testWorkItemType.Color,
+			Icon:        testWorkItemType.Icon,
+			IsDisabled:  testWorkItemType.IsDisabled,
+		},
+	}
+	processClient.
+		EXPECT().
+		CreateProcessWorkItemType(clients.Ctx, expectedArgs).
+		Return(nil, errors.New("CreateProcessWorkItemType() Failed")).
+		Times(1)
+
+	err := resourceWorkItemTypeCreate(resourceData, clients)
+	require.Contains(t, err.Error(), "CreateProcessWorkItemType() Failed")
+}
+
+// verifies that the reference name is used to update the work item type and that it reads back the result
+func TestAzureDevOpsWorkItemType_Update_UpdatesAndReads(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	processClient := azdosdkmocks.NewMockWorkitemtrackingprocessClient(ctrl)
+	clients := &config.AggregatedClient{
+		WorkItemTrackingProcessClient: processClient,
+		Ctx:                           context.Background(),
+	}
+
+	resourceData := schema.TestResourceDataRaw(t, resourceWorkItemType().Schema, nil)
+	resourceData.Set("process_id", testWorkItemTypeProcessID.String())
+	flattenWorkItemType(resourceData, &testWorkItemType)
+
+	processClient.
+		EXPECT().
+		UpdateProcessWorkItemType(clients.Ctx, workitemtrackingprocess.UpdateProcessWorkItemTypeArgs{
+			ProcessId:  &testWorkItemTypeProcessID,
+			WitRefName: testWorkItemType.ReferenceName,
+			WorkItemTypeUpdate: &workitemtrackingprocess.UpdateProcessWorkItemTypeRequest{
+				Description: testWorkItemType.Description,
+				Color:       testWorkItemType.Color,
+				Icon:        testWorkItemType.Icon,
+				IsDisabled:  testWorkItemType.IsDisabled,
+			},
+		}).
+		Return(&testWorkItemType, nil).
+		Times(1)
+	processClient.
+		EXPECT().
+		GetProcessWorkItemType(clients.Ctx, workitemtrackingprocess.GetProcessWorkItemTypeArgs{
+			ProcessId:  &testWorkItemTypeProcessID,
+			WitRefName: testWorkItemType.ReferenceName,
+		}).
+		Return(&testWorkItemType, nil).
+		Times(1)
+
+	err := resourceWorkItemTypeUpdate(resourceData, clients)
+	require.Nil(t, err)
+	require.Equal(t, "MyAgile.CustomerRequest", resourceData.Get("reference_name"))
+}
+
+func TestAzureDevOpsWorkItemType_Read_ChecksForValidProcessUUID(t *testing.T) {
+	resourceData := schema.TestResourceDataRaw(t, resourceWorkItemType().Schema, nil)
+	resourceData.Set("process_id", "not-a-uuid")
+	resourceData.SetId("MyAgile.CustomerRequest")
+
+	err := resourceWorkItemTypeRead(resourceData, &config.AggregatedClient{})
+	require.NotNil(t, err)
+	require.Contains(t, err.Error(), "Invalid process UUID")
+}
+
+// verifies that the import IDs of work item types and the objects owned by them are parsed correctly
+func TestAzureDevOpsWorkItemType_Import_ParsesID(t *testing.T) {
+	resourceData := schema.TestResourceDataRaw(t, resourceWorkItemType().Schema, nil)
+	resourceData.SetId(testWorkItemTypeProcessID.String() + "/MyAgile.CustomerRequest")
+	_, err := importWorkItemType(resourceData, nil)
+	require.Nil(t, err)
+	require.Equal(t, testWorkItemTypeProcessID.String(), resourceData.Get("process_id"))
+	require.Equal(t, "MyAgile.CustomerRequest", resourceData.Id())
+
+	childData := schema.TestResourceDataRaw(t, resourceWorkItemTypeField().Schema, nil)
+	childData.SetId(testWorkItemTypeProcessID.String() + "/MyAgile.CustomerRequest/Custom.CustomerImpact")
+	_, err = importWorkItemTypeChild(childData, nil)
+	require.Nil(t, err)
+	require.Equal(t, testWorkItemTypeProcessID.String(), childData.Get("process_id"))
+	require.Equal(t, "MyAgile.CustomerRequest", childData.Get("work_item_type_id"))
+	require.Equal(t, "Custom.CustomerImpact", childData.Id())
+
+	invalidData := schema.TestResourceDataRaw(t, resourceWorkItemTypeField().Schema, nil)
+	invalidData.SetId("MyAgile.CustomerRequest")
+	_, err = importWorkItemTypeChild(invalidData, nil)
+	require.NotNil(t, err)
+}
+
+/**
+ * Begin acceptance tests
+ */
+
+// Verifies that a work item type, along with a field, state and rule, can be added to an
+// inherited process. The process is identified by the AZDO_TEST_PROCESS_ID environment variable.
+func TestAccAzureDevOpsWorkItemType_CreateAndUpdate(t *testing.T) {
+	witName := testhelper.TestAccResourcePrefix + acctest.RandStringFromCharSet(10, acctest.CharSetAlphaNum)
+	processID := os.Getenv("AZDO_TEST_PROCESS_ID")
+	tfNode := "azuredevops_workitemtype.wit"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testhelper.TestAccPreCheck(t, &[]string{"AZDO_TEST_PROCESS_ID"}) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccWorkItemTypeCheckDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testhelper.TestAccWorkItemTypeResource(processID, witName, "first description"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(tfNode, "name", witName),
+					resource.TestCheckResourceAttr(tfNode, "description", "first description"),
+					resource.TestCheckResourceAttrSet(tfNode, "reference_name"),
+					resource.TestCheckResourceAttrSet("azuredevops_workitemtype_state.state", "id"),
+					resource.TestCheckResourceAttrSet("azuredevops_workitemtype_rule.rule", "id"),
+				),
+			},
+			{
+				Config: testhelper.TestAccWorkItemTypeResource(processID, witName, "second description"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(tfNode, "description", "second description"),
+				),
+			},
+		},
+	})
+}
+
+// verifies that the work item types referenced in the state are destroyed.
+func testAccWorkItemTypeCheckDestroy(s *terraform.State) error {
+	clients := testAccProvider.Meta().(*config.AggregatedClient)
+
+	for _, resource := range s.RootModule().Resources {
+		if resource.Type != "azuredevops_workitemtype" {
+			continue
+		}
+
+		processID, err := uuid.Parse(resource.Primary.Attributes["process_id"])
+		if err != nil {
+			return err
+		}
+
+		// indicates the work item type still exists - this should fail the test
+		args := workitemtrackingprocess.GetProcessWorkItemTypeArgs{
+			ProcessId:  &processID,
+			WitRefName: converter.String(resource.Primary.ID),
+		}
+		if _, err := clients.WorkItemTrackingProcessClient.GetProcessWorkItemType(clients.Ctx, args); err == nil {
+			return fmt.Errorf("Work item type %s should not exist", resource.Primary.ID)
+		}
+	}
+
+	return nil
+}
+
+func init() {
+	InitProvider()
+}
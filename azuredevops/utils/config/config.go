@@ -10,10 +10,16 @@ import (
 	"github.com/microsoft/azure-devops-go-api/azuredevops/core"
 	"github.com/microsoft/azure-devops-go-api/azuredevops/git"
 	"github.com/microsoft/azure-devops-go-api/azuredevops/graph"
+	"github.com/microsoft/azure-devops-go-api/azuredevops/identity"
 	"github.com/microsoft/azure-devops-go-api/azuredevops/memberentitlementmanagement"
 	"github.com/microsoft/azure-devops-go-api/azuredevops/operations"
+	"github.com/microsoft/azure-devops-go-api/azuredevops/policy"
+	"github.com/microsoft/azure-devops-go-api/azuredevops/security"
 	"github.com/microsoft/azure-devops-go-api/azuredevops/serviceendpoint"
 	"github.com/microsoft/azure-devops-go-api/azuredevops/taskagent"
+	"github.com/microsoft/azure-devops-go-api/azuredevops/work"
+	"github.com/microsoft/azure-devops-go-api/azuredevops/workitemtracking"
+	"github.com/microsoft/azure-devops-go-api/azuredevops/workitemtrackingprocess"
 )
 
 // AggregatedClient aggregates all of the underlying clients into a single data
@@ -29,9 +35,15 @@ type AggregatedClient struct {
 	GitReposClient                git.Client
 	GraphClient                   graph.Client
 	OperationsClient              operations.Client
+	PolicyClient                  policy.Client
 	ServiceEndpointClient         serviceendpoint.Client
 	TaskAgentClient               taskagent.Client
 	MemberEntitleManagementClient memberentitlementmanagement.Client
+	WorkItemTrackingClient        workitemtracking.Client
+	WorkItemTrackingProcessClient workitemtrackingprocess.Client
+	IdentityClient                identity.Client
+	SecurityClient                security.Client
+	WorkClient                    work.Client
 	Ctx                           context.Context
 }
 
@@ -105,15 +117,65 @@ func GetAzdoClient(azdoPAT string, organizationURL string) (*AggregatedClient, e
 		return nil, err
 	}
 
+	// client for these APIs (includes CRUD for AzDO work item fields and classification nodes):
+	//	https://docs.microsoft.com/en-us/rest/api/azure/devops/wit/?view=azure-devops-rest-5.1
+	workItemTrackingClient, err := workitemtracking.NewClient(ctx, connection)
+	if err != nil {
+		log.Printf("getAzdoClient(): workitemtracking.NewClient failed.")
+		return nil, err
+	}
+
+	// client for these APIs (includes CRUD for work item types of inherited processes):
+	//	https://docs.microsoft.com/en-us/rest/api/azure/devops/processes/?view=azure-devops-rest-5.1
+	workItemTrackingProcessClient, err := workitemtrackingprocess.NewClient(ctx, connection)
+	if err != nil {
+		log.Printf("getAzdoClient(): workitemtrackingprocess.NewClient failed.")
+		return nil, err
+	}
+
+	// client for these APIs (resolves identity descriptors of users, groups and teams):
+	//	https://docs.microsoft.com/en-us/rest/api/azure/devops/ims/?view=azure-devops-rest-5.1
+	identityClient, err := identity.NewClient(ctx, connection)
+	if err != nil {
+		log.Printf("getAzdoClient(): identity.NewClient failed.")
+		return nil, err
+	}
+
+	// client for these APIs (includes CRUD for access control entries of security namespaces):
+	//	https://docs.microsoft.com/en-us/rest/api/azure/devops/security/?view=azure-devops-rest-5.1
+	securityClient := security.NewClient(ctx, connection)
+
+	// client for these APIs (includes team settings, team field values and team iterations):
+	//	https://docs.microsoft.com/en-us/rest/api/azure/devops/work/?view=azure-devops-rest-5.1
+	workClient, err := work.NewClient(ctx, connection)
+	if err != nil {
+		log.Printf("getAzdoClient(): work.NewClient failed.")
+		return nil, err
+	}
+
+	// client for these APIs (includes CRUD for branch and repository policies):
+	//	https://docs.microsoft.com/en-us/rest/api/azure/devops/policy/?view=azure-devops-rest-5.1
+	policyClient, err := policy.NewClient(ctx, connection)
+	if err != nil {
+		log.Printf("getAzdoClient(): policy.NewClient failed.")
+		return nil, err
+	}
+
 	aggregatedClient := &AggregatedClient{
 		CoreClient:                    coreClient,
 		BuildClient:                   buildClient,
 		GitReposClient:                gitReposClient,
 		GraphClient:                   graphClient,
 		OperationsClient:              operationsClient,
+		PolicyClient:                  policyClient,
 		ServiceEndpointClient:         serviceEndpointClient,
 		TaskAgentClient:               taskagentClient,
 		MemberEntitleManagementClient: memberentitlementmanagementClient,
+		WorkItemTrackingClient:        workItemTrackingClient,
+		WorkItemTrackingProcessClient: workItemTrackingProcessClient,
+		IdentityClient:                identityClient,
+		SecurityClient:                securityClient,
+		WorkClient:                    workClient,
 		Ctx:                           ctx,
 	}
 
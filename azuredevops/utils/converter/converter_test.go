@@ -26,6 +26,12 @@ func TestInt(t *testing.T) {
 	}
 }
 
+func TestToInt(t *testing.T) {
+	value := 123456
+	assert.Equal(t, value, ToInt(&value, 0))
+	assert.Equal(t, 42, ToInt(nil, 42))
+}
+
 func TestBoolTrue(t *testing.T) {
 	value := true
 	valuePtr := Bool(value)
@@ -20,6 +20,337 @@ resource "azuredevops_azure_git_repository" "gitrepo" {
 	return fmt.Sprintf("%s\n%s", projectResource, azureGitRepoResource)
 }
 
+// TestAccAzureGitRepoSettingsResource HCL describing an AzDO GIT repository with repository settings
+func TestAccAzureGitRepoSettingsResource(projectName string, gitRepoName string, forksAllowed bool, disabled bool) string {
+	azureGitRepoResource := fmt.Sprintf(`
+resource "azuredevops_azure_git_repository" "gitrepo" {
+	project_id      = azuredevops_project.project.id
+	name            = "%s"
+	forks_allowed   = %t
+	disabled        = %t
+	initialization {
+		init_type = "Clean"
+	}
+}`, gitRepoName, forksAllowed, disabled)
+
+	projectResource := TestAccProjectResource(projectName)
+	return fmt.Sprintf("%s\n%s", projectResource, azureGitRepoResource)
+}
+
+// TestAccAzureGitRepoCustomInitializationResource HCL describing an AzDO GIT repository initialized on the main branch
+func TestAccAzureGitRepoCustomInitializationResource(projectName string, gitRepoName string, defaultBranch string) string {
+	azureGitRepoResource := fmt.Sprintf(`
+resource "azuredevops_azure_git_repository" "gitrepo" {
+	project_id      = azuredevops_project.project.id
+	name            = "%s"
+	default_branch  = "%s"
+	initialization {
+		init_type      = "Clean"
+		initial_branch = "main"
+		commit_message = "Add readme"
+		file {
+			path    = "README.md"
+			content = "# %s"
+		}
+	}
+}`, gitRepoName, defaultBranch, gitRepoName)
+
+	projectResource := TestAccProjectResource(projectName)
+	return fmt.Sprintf("%s\n%s", projectResource, azureGitRepoResource)
+}
+
+// TestAccAzureGitRepoImportResource HCL describing an AzDO GIT repository imported from a public Git repository
+func TestAccAzureGitRepoImportResource(projectName string, gitRepoName string, sourceURL string) string {
+	azureGitRepoResource := fmt.Sprintf(`
+resource "azuredevops_azure_git_repository" "gitrepo" {
+	project_id      = azuredevops_project.project.id
+	name            = "%s"
+	initialization {
+		init_type   = "Import"
+		source_type = "Git"
+		source_url  = "%s"
+	}
+}`, gitRepoName, sourceURL)
+
+	projectResource := TestAccProjectResource(projectName)
+	return fmt.Sprintf("%s\n%s", projectResource, azureGitRepoResource)
+}
+
+// TestAccAzureGitRepoForkResource HCL describing an AzDO GIT repository and a fork of that repository
+func TestAccAzureGitRepoForkResource(projectName string, gitRepoName string, forkRepoName string) string {
+	azureGitRepoResource := TestAccAzureGitRepoResource(projectName, gitRepoName, "Clean")
+	forkResource := fmt.Sprintf(`
+resource "azuredevops_azure_git_repository" "fork" {
+	project_id      = azuredevops_project.project.id
+	name            = "%s"
+	initialization {
+		init_type            = "Fork"
+		source_repository_id = azuredevops_azure_git_repository.gitrepo.id
+	}
+}`, forkRepoName)
+
+	return fmt.Sprintf("%s\n%s", azureGitRepoResource, forkResource)
+}
+
+// TestAccGitRepositoryFileResource HCL describing a file in an AzDO GIT repository
+func TestAccGitRepositoryFileResource(projectName string, gitRepoName string, content string) string {
+	fileResource := fmt.Sprintf(`
+resource "azuredevops_git_repository_file" "file" {
+	repository_id  = azuredevops_azure_git_repository.gitrepo.id
+	file           = "/docs/README.md"
+	content        = "%s"
+	branch         = "master"
+	commit_message = "Update documentation"
+	author_name    = "Terraform"
+	author_email   = "terraform@example.com"
+}`, content)
+
+	gitRepoResource := TestAccAzureGitRepoResource(projectName, gitRepoName, "Clean")
+	return fmt.Sprintf("%s\n%s", gitRepoResource, fileResource)
+}
+
+// TestAccGitBranchAndTagResources HCL describing a branch and a tag created from the master branch of an AzDO GIT repository
+func TestAccGitBranchAndTagResources(projectName string, gitRepoName string, locked bool) string {
+	refResources := fmt.Sprintf(`
+resource "azuredevops_git_branch" "branch" {
+	repository_id = azuredevops_azure_git_repository.gitrepo.id
+	name          = "release/1.0"
+	ref_branch    = "master"
+	locked        = %t
+}
+
+resource "azuredevops_git_tag" "tag" {
+	project_id    = azuredevops_project.project.id
+	repository_id = azuredevops_azure_git_repository.gitrepo.id
+	name          = "v1.0"
+	message       = "Release 1.0"
+	ref_branch    = "master"
+}`, locked)
+
+	gitRepoResource := TestAccAzureGitRepoResource(projectName, gitRepoName, "Clean")
+	return fmt.Sprintf("%s\n%s", gitRepoResource, refResources)
+}
+
+// TestAccBranchPolicyMinReviewersResource HCL describing a minimum reviewers policy on the master branch of a repository
+func TestAccBranchPolicyMinReviewersResource(projectName string, gitRepoName string, reviewerCount int) string {
+	policyResource := fmt.Sprintf(`
+resource "azuredevops_branch_policy_min_reviewers" "policy" {
+	project_id = azuredevops_project.project.id
+
+	settings {
+		reviewer_count               = %d
+		on_push_reset_approved_votes = true
+
+		scope {
+			repository_id  = azuredevops_azure_git_repository.gitrepo.id
+			repository_ref = azuredevops_azure_git_repository.gitrepo.default_branch
+			match_type     = "Exact"
+		}
+	}
+}`, reviewerCount)
+
+	gitRepoResource := TestAccAzureGitRepoResource(projectName, gitRepoName, "Clean")
+	return fmt.Sprintf("%s\n%s", gitRepoResource, policyResource)
+}
+
+// TestAccBranchPolicyBuildValidationResource HCL describing a build validation policy on the master branch of a
+// repository, which queues a build definition of the repository
+func TestAccBranchPolicyBuildValidationResource(projectName string, gitRepoName string, displayName string) string {
+	policyResource := fmt.Sprintf(`
+resource "azuredevops_build_definition" "build" {
+	project_id      = azuredevops_project.project.id
+	name            = "validation"
+	agent_pool_name = "Hosted Ubuntu 1604"
+
+	repository {
+		repo_type   = "TfsGit"
+		repo_name   = azuredevops_azure_git_repository.gitrepo.id
+		branch_name = azuredevops_azure_git_repository.gitrepo.default_branch
+		yml_path    = "azure-pipelines.yml"
+	}
+}
+
+resource "azuredevops_branch_policy_build_validation" "policy" {
+	project_id = azuredevops_project.project.id
+
+	settings {
+		build_definition_id = azuredevops_build_definition.build.id
+		display_name        = "%s"
+		filename_patterns   = ["/src/*", "!/src/*.md"]
+		valid_duration      = 60
+
+		scope {
+			repository_id  = azuredevops_azure_git_repository.gitrepo.id
+			repository_ref = azuredevops_azure_git_repository.gitrepo.default_branch
+			match_type     = "Exact"
+		}
+	}
+}`, displayName)
+
+	gitRepoResource := TestAccAzureGitRepoResource(projectName, gitRepoName, "Clean")
+	return fmt.Sprintf("%s\n%s", gitRepoResource, policyResource)
+}
+
+// TestAccBranchPolicyAutoReviewersResource HCL describing an automatically included reviewers policy on the master
+// branch of a repository, which adds the contributors of the project as reviewers
+func TestAccBranchPolicyAutoReviewersResource(projectName string, gitRepoName string, pathFilter string) string {
+	policyResource := fmt.Sprintf(`
+data "azuredevops_group" "contributors" {
+	project_id = azuredevops_project.project.id
+	name       = "Contributors"
+}
+
+resource "azuredevops_branch_policy_auto_reviewers" "policy" {
+	project_id = azuredevops_project.project.id
+	blocking   = false
+
+	settings {
+		auto_reviewer_ids           = [data.azuredevops_group.contributors.descriptor]
+		path_filters                = ["%s"]
+		minimum_number_of_reviewers = 1
+		message                     = "Contributors were added as reviewers"
+
+		scope {
+			repository_id  = azuredevops_azure_git_repository.gitrepo.id
+			repository_ref = azuredevops_azure_git_repository.gitrepo.default_branch
+			match_type     = "Exact"
+		}
+	}
+}`, pathFilter)
+
+	gitRepoResource := TestAccAzureGitRepoResource(projectName, gitRepoName, "Clean")
+	return fmt.Sprintf("%s\n%s", gitRepoResource, policyResource)
+}
+
+// TestAccBranchPolicyPullRequestResources HCL describing merge types, comment resolution and work item linking
+// policies on the default branch of all repositories of a project
+func TestAccBranchPolicyPullRequestResources(projectName string, gitRepoName string, blocking bool) string {
+	policyResources := fmt.Sprintf(`
+resource "azuredevops_branch_policy_merge_types" "policy" {
+	project_id = azuredevops_project.project.id
+	blocking   = %[1]t
+
+	settings {
+		allow_squash                  = true
+		allow_rebase_and_fast_forward = %[1]t
+
+		scope {
+			match_type = "DefaultBranch"
+		}
+	}
+}
+
+resource "azuredevops_branch_policy_comment_resolution" "policy" {
+	project_id = azuredevops_project.project.id
+	blocking   = %[1]t
+
+	settings {
+		scope {
+			match_type = "DefaultBranch"
+		}
+	}
+}
+
+resource "azuredevops_branch_policy_work_item_linking" "policy" {
+	project_id = azuredevops_project.project.id
+	blocking   = %[1]t
+
+	settings {
+		scope {
+			repository_id  = azuredevops_azure_git_repository.gitrepo.id
+			repository_ref = "refs/heads/releases"
+			match_type     = "Prefix"
+		}
+	}
+}`, blocking)
+
+	gitRepoResource := TestAccAzureGitRepoResource(projectName, gitRepoName, "Clean")
+	return fmt.Sprintf("%s\n%s", gitRepoResource, policyResources)
+}
+
+// TestAccBranchPolicyStatusCheckResource HCL describing a status check policy on the master branch of a repository
+func TestAccBranchPolicyStatusCheckResource(projectName string, gitRepoName string, invalidateOnUpdate bool) string {
+	policyResource := fmt.Sprintf(`
+resource "azuredevops_branch_policy_status_check" "policy" {
+	project_id = azuredevops_project.project.id
+
+	settings {
+		genre                = "security"
+		name                 = "scan"
+		display_name         = "Security scan"
+		invalidate_on_update = %t
+		filename_patterns    = ["/src/*"]
+
+		scope {
+			repository_id  = azuredevops_azure_git_repository.gitrepo.id
+			repository_ref = azuredevops_azure_git_repository.gitrepo.default_branch
+			match_type     = "Exact"
+		}
+	}
+}`, invalidateOnUpdate)
+
+	gitRepoResource := TestAccAzureGitRepoResource(projectName, gitRepoName, "Clean")
+	return fmt.Sprintf("%s\n%s", gitRepoResource, policyResource)
+}
+
+// TestAccRepositoryPolicyResources HCL describing repository policies. The maximum file size policy applies to one
+// repository, all other policies to the whole project.
+func TestAccRepositoryPolicyResources(projectName string, gitRepoName string, maxFileSize int) string {
+	policyResources := fmt.Sprintf(`
+resource "azuredevops_repository_policy_max_file_size" "policy" {
+	project_id = azuredevops_project.project.id
+
+	settings {
+		max_file_size = %d
+
+		scope {
+			repository_id = azuredevops_azure_git_repository.gitrepo.id
+		}
+	}
+}
+
+resource "azuredevops_repository_policy_max_path_length" "policy" {
+	project_id = azuredevops_project.project.id
+
+	settings {
+		max_path_length = 248
+
+		scope {}
+	}
+}
+
+resource "azuredevops_repository_policy_reserved_names" "policy" {
+	project_id = azuredevops_project.project.id
+
+	settings {
+		scope {}
+	}
+}
+
+resource "azuredevops_repository_policy_file_path_patterns" "policy" {
+	project_id = azuredevops_project.project.id
+
+	settings {
+		filepath_patterns = ["*.exe", "/bin/*"]
+
+		scope {}
+	}
+}
+
+resource "azuredevops_repository_policy_case_enforcement" "policy" {
+	project_id = azuredevops_project.project.id
+
+	settings {
+		enforce_consistent_case = true
+
+		scope {}
+	}
+}`, maxFileSize)
+
+	gitRepoResource := TestAccAzureGitRepoResource(projectName, gitRepoName, "Clean")
+	return fmt.Sprintf("%s\n%s", gitRepoResource, policyResources)
+}
+
 // TestAccGroupDataSource HCL describing an AzDO Group Data Source
 func TestAccGroupDataSource(projectName string, groupName string) string {
 	dataSource := fmt.Sprintf(`
@@ -32,6 +363,50 @@ data "azuredevops_group" "group" {
 	return fmt.Sprintf("%s\n%s", projectResource, dataSource)
 }
 
+// TestAccGitRepositoriesDataSource HCL describing an AzDO Git repositories data source that looks up a repository
+// by its name
+func TestAccGitRepositoriesDataSource(projectName string, gitRepoName string) string {
+	dataSource := `
+data "azuredevops_git_repositories" "repositories" {
+	project_id = azuredevops_project.project.id
+	name       = azuredevops_azure_git_repository.gitrepo.name
+}`
+
+	gitRepoResource := TestAccAzureGitRepoResource(projectName, gitRepoName, "Clean")
+	return fmt.Sprintf("%s\n%s", gitRepoResource, dataSource)
+}
+
+// TestAccGitBranchesAndFileDataSources HCL describing data sources reading the branches and a file of an AzDO GIT repository
+func TestAccGitBranchesAndFileDataSources(projectName string, gitRepoName string) string {
+	dataSources := `
+resource "azuredevops_git_branch" "branch" {
+	repository_id = azuredevops_azure_git_repository.gitrepo.id
+	name          = "release/1.0"
+	ref_branch    = "master"
+}
+
+resource "azuredevops_git_repository_file" "file" {
+	repository_id = azuredevops_azure_git_repository.gitrepo.id
+	file          = "/VERSION"
+	content       = "1.0.0"
+	branch        = "master"
+}
+
+data "azuredevops_git_branches" "branches" {
+	repository_id = azuredevops_git_branch.branch.repository_id
+	prefix        = "release/"
+}
+
+data "azuredevops_git_repository_file" "file" {
+	repository_id = azuredevops_git_repository_file.file.repository_id
+	file          = azuredevops_git_repository_file.file.file
+	branch        = azuredevops_git_repository_file.file.branch
+}`
+
+	gitRepoResource := TestAccAzureGitRepoResource(projectName, gitRepoName, "Clean")
+	return fmt.Sprintf("%s\n%s", gitRepoResource, dataSources)
+}
+
 // TestAccProjectResource HCL describing an AzDO project
 func TestAccProjectResource(projectName string) string {
 	if projectName == "" {
@@ -191,3 +566,314 @@ output "group_id_%s" {
 }
 `, TestAccProjectResource(projectName), groupResourceName, groupName, groupResourceName, groupResourceName)
 }
+
+// TestAccWorkItemPicklistResource HCL describing an AzDO work item picklist
+func TestAccWorkItemPicklistResource(picklistName string, items []string) string {
+	return fmt.Sprintf(`
+resource "azuredevops_workitem_picklist" "picklist" {
+	name  = "%s"
+	items = ["%s"]
+}`, picklistName, strings.Join(items, `", "`))
+}
+
+// TestAccWorkItemFieldResource HCL describing an AzDO work item field backed by a picklist
+func TestAccWorkItemFieldResource(fieldName string, referenceName string) string {
+	fieldResource := fmt.Sprintf(`
+resource "azuredevops_workitem_field" "field" {
+	name           = "%s"
+	reference_name = "%s"
+	type           = "string"
+	description    = "%s-description"
+	picklist_id    = azuredevops_workitem_picklist.picklist.id
+}`, fieldName, referenceName, fieldName)
+
+	picklistResource := TestAccWorkItemPicklistResource(fieldName+"-picklist", []string{"Low", "Medium", "High"})
+	return fmt.Sprintf("%s\n%s", picklistResource, fieldResource)
+}
+
+// TestAccWorkItemTypeResource HCL describing an AzDO work item type in an inherited process,
+// along with a state and a rule owned by the work item type
+func TestAccWorkItemTypeResource(processID string, witName string, description string) string {
+	return fmt.Sprintf(`
+resource "azuredevops_workitemtype" "wit" {
+	process_id  = "%s"
+	name        = "%s"
+	description = "%s"
+}
+
+resource "azuredevops_workitemtype_state" "state" {
+	process_id        = azuredevops_workitemtype.wit.process_id
+	work_item_type_id = azuredevops_workitemtype.wit.id
+	name              = "Triaged"
+	color             = "b2b2b2"
+	state_category    = "Proposed"
+}
+
+resource "azuredevops_workitemtype_rule" "rule" {
+	process_id        = azuredevops_workitemtype.wit.process_id
+	work_item_type_id = azuredevops_workitemtype.wit.id
+	name              = "Title is read only when triaged"
+
+	condition {
+		condition_type = "whenStateChangedTo"
+		value          = azuredevops_workitemtype_state.state.name
+	}
+
+	action {
+		action_type  = "makeReadOnly"
+		target_field = "System.Title"
+	}
+}`, processID, witName, description)
+}
+
+// TestAccProjectPropertiesResource HCL describing a set of properties of an AzDO project
+func TestAccProjectPropertiesResource(projectName string, owner string) string {
+	propertiesResource := fmt.Sprintf(`
+resource "azuredevops_project_properties" "properties" {
+	project_id = azuredevops_project.project.id
+	properties = {
+		"CostCentre" = "cc-1234"
+		"Owner"      = "%s"
+	}
+}
+
+data "azuredevops_project_properties" "properties" {
+	project_id = azuredevops_project_properties.properties.project_id
+	keys       = ["Owner"]
+}`, owner)
+
+	projectResource := TestAccProjectResource(projectName)
+	return fmt.Sprintf("%s\n%s", projectResource, propertiesResource)
+}
+
+// TestAccTeamResource HCL describing an AzDO team, administered by the project readers group
+func TestAccTeamResource(projectName string, teamName string, description string) string {
+	teamResource := fmt.Sprintf(`
+data "azuredevops_group" "readers" {
+	project_id = azuredevops_project.project.id
+	name       = "Readers"
+}
+
+resource "azuredevops_team" "team" {
+	project_id     = azuredevops_project.project.id
+	name           = "%s"
+	description    = "%s"
+	administrators = [data.azuredevops_group.readers.descriptor]
+}
+
+data "azuredevops_teams" "teams" {
+	project_id = azuredevops_team.team.project_id
+}`, teamName, description)
+
+	projectResource := TestAccProjectResource(projectName)
+	return fmt.Sprintf("%s\n%s", projectResource, teamResource)
+}
+
+// TestAccTeamSettingsResource HCL describing the settings of an AzDO team
+func TestAccTeamSettingsResource(projectName string, teamName string, bugsBehavior string) string {
+	teamSettingsResource := fmt.Sprintf(`
+resource "azuredevops_team_settings" "settings" {
+	project_id    = azuredevops_team.team.project_id
+	team_id       = azuredevops_team.team.id
+	working_days  = ["monday", "tuesday", "wednesday", "thursday"]
+	bugs_behavior = "%s"
+}`, bugsBehavior)
+
+	teamResource := TestAccTeamResource(projectName, teamName, "")
+	return fmt.Sprintf("%s\n%s", teamResource, teamSettingsResource)
+}
+
+// TestAccClassificationNodeResources HCL describing a nested AzDO area and an iteration with start and finish dates
+func TestAccClassificationNodeResources(projectName string, iterationName string, finishDate string) string {
+	nodeResources := fmt.Sprintf(`
+resource "azuredevops_area" "parent" {
+	project_id = azuredevops_project.project.id
+	name       = "Platform"
+}
+
+resource "azuredevops_area" "area" {
+	project_id  = azuredevops_project.project.id
+	name        = "Services"
+	parent_path = azuredevops_area.parent.name
+}
+
+resource "azuredevops_iteration" "iteration" {
+	project_id  = azuredevops_project.project.id
+	name        = "%s"
+	start_date  = "2020-01-06"
+	finish_date = "%s"
+}`, iterationName, finishDate)
+
+	projectResource := TestAccProjectResource(projectName)
+	return fmt.Sprintf("%s\n%s", projectResource, nodeResources)
+}
+
+// TestAccSecurityPermissionsResource HCL describing the permissions of the readers group on an AzDO project
+func TestAccSecurityPermissionsResource(projectName string, deletePermission string) string {
+	permissionsResource := fmt.Sprintf(`
+data "azuredevops_group" "readers" {
+	project_id = azuredevops_project.project.id
+	name       = "Readers"
+}
+
+resource "azuredevops_security_permissions" "permissions" {
+	namespace_id = "52d39943-cb85-4d7f-8fa8-c6baac873819"
+	token        = "$PROJECT:vstfs:///Classification/TeamProject/${azuredevops_project.project.id}"
+	principal    = data.azuredevops_group.readers.descriptor
+	permissions  = {
+		GENERIC_READ = "Allow"
+		DELETE       = "%s"
+	}
+}`, deletePermission)
+
+	projectResource := TestAccProjectResource(projectName)
+	return fmt.Sprintf("%s\n%s", projectResource, permissionsResource)
+}
+
+// TestAccProjectPermissionsResource HCL describing the permissions of the readers group on an AzDO project
+func TestAccProjectPermissionsResource(projectName string, renamePermission string) string {
+	permissionsResource := fmt.Sprintf(`
+data "azuredevops_group" "readers" {
+	project_id = azuredevops_project.project.id
+	name       = "Readers"
+}
+
+resource "azuredevops_project_permissions" "permissions" {
+	project_id  = azuredevops_project.project.id
+	principal   = data.azuredevops_group.readers.descriptor
+	replace     = false
+	permissions = {
+		GENERIC_READ      = "Allow"
+		DELETE            = "Deny"
+		MANAGE_PROPERTIES = "Deny"
+		RENAME            = "%s"
+	}
+}`, renamePermission)
+
+	projectResource := TestAccProjectResource(projectName)
+	return fmt.Sprintf("%s\n%s", projectResource, permissionsResource)
+}
+
+// TestAccGitPermissionsResource HCL describing the permissions of the readers group on an AzDO Git repository and its master branch
+func TestAccGitPermissionsResource(projectName string, gitRepoName string, forcePushPermission string) string {
+	permissionsResource := fmt.Sprintf(`
+data "azuredevops_group" "readers" {
+	project_id = azuredevops_project.project.id
+	name       = "Readers"
+}
+
+resource "azuredevops_git_permissions" "repository" {
+	project_id    = azuredevops_project.project.id
+	repository_id = azuredevops_azure_git_repository.gitrepo.id
+	principal     = data.azuredevops_group.readers.descriptor
+	permissions   = {
+		GenericContribute = "Allow"
+		ForcePush         = "%s"
+	}
+}
+
+resource "azuredevops_git_permissions" "branch" {
+	project_id    = azuredevops_project.project.id
+	repository_id = azuredevops_azure_git_repository.gitrepo.id
+	branch_name   = "master"
+	principal     = data.azuredevops_group.readers.descriptor
+	inherit       = false
+	permissions   = {
+		PolicyExempt = "Deny"
+	}
+}`, forcePushPermission)
+
+	gitRepoResource := TestAccAzureGitRepoResource(projectName, gitRepoName, "Clean")
+	return fmt.Sprintf("%s\n%s", gitRepoResource, permissionsResource)
+}
+
+// TestAccBuildPermissionsResource HCL describing the permissions of the readers group on an AzDO build folder and build definition
+func TestAccBuildPermissionsResource(projectName string, buildDefinitionName string, queueBuildsPermission string) string {
+	permissionsResource := fmt.Sprintf(`
+data "azuredevops_group" "readers" {
+	project_id = azuredevops_project.project.id
+	name       = "Readers"
+}
+
+resource "azuredevops_build_permissions" "folder" {
+	project_id  = azuredevops_project.project.id
+	path        = azuredevops_build_definition.build.path
+	principal   = data.azuredevops_group.readers.descriptor
+	permissions = {
+		QueueBuilds = "%s"
+	}
+}
+
+resource "azuredevops_build_permissions" "definition" {
+	project_id          = azuredevops_project.project.id
+	build_definition_id = azuredevops_build_definition.build.id
+	principal           = data.azuredevops_group.readers.descriptor
+	permissions         = {
+		EditBuildDefinition        = "Deny"
+		DeleteBuilds               = "Deny"
+		AdministerBuildPermissions = "Deny"
+	}
+}`, queueBuildsPermission)
+
+	buildDefinitionResource := TestAccBuildDefinitionResource(projectName, buildDefinitionName, `\folder`)
+	return fmt.Sprintf("%s\n%s", buildDefinitionResource, permissionsResource)
+}
+
+// TestAccResourceRoleAssignmentResource HCL describing the role of the readers group on an AzDO variable group and on the project library
+func TestAccResourceRoleAssignmentResource(projectName string, variableGroupName string, roleName string) string {
+	roleAssignmentResource := fmt.Sprintf(`
+data "azuredevops_group" "readers" {
+	project_id = azuredevops_project.project.id
+	name       = "Readers"
+}
+
+resource "azuredevops_resource_role_assignment" "variable_group" {
+	resource_type = "variablegroup"
+	project_id    = azuredevops_project.project.id
+	resource_id   = azuredevops_variable_group.vg.id
+	principal     = data.azuredevops_group.readers.descriptor
+	role_name     = "%s"
+}
+
+resource "azuredevops_resource_role_assignment" "library" {
+	resource_type = "variablegroup"
+	project_id    = azuredevops_project.project.id
+	principal     = data.azuredevops_group.readers.descriptor
+	role_name     = "Creator"
+}`, roleName)
+
+	variableGroupResource := TestAccVariableGroupResource(projectName, variableGroupName, false)
+	return fmt.Sprintf("%s\n%s", variableGroupResource, roleAssignmentResource)
+}
+
+// TestAccClassificationNodePermissionsResources HCL describing the permissions of the readers group on an AzDO area and iteration
+func TestAccClassificationNodePermissionsResources(projectName string, workItemWritePermission string) string {
+	permissionsResources := fmt.Sprintf(`
+data "azuredevops_group" "readers" {
+	project_id = azuredevops_project.project.id
+	name       = "Readers"
+}
+
+resource "azuredevops_area_permissions" "area" {
+	project_id  = azuredevops_project.project.id
+	path        = "${azuredevops_area.area.parent_path}/${azuredevops_area.area.name}"
+	principal   = data.azuredevops_group.readers.descriptor
+	permissions = {
+		GENERIC_WRITE   = "Deny"
+		WORK_ITEM_READ  = "Allow"
+		WORK_ITEM_WRITE = "%s"
+	}
+}
+
+resource "azuredevops_iteration_permissions" "iteration" {
+	project_id  = azuredevops_project.project.id
+	path        = azuredevops_iteration.iteration.name
+	principal   = data.azuredevops_group.readers.descriptor
+	permissions = {
+		CREATE_CHILDREN = "Deny"
+	}
+}`, workItemWritePermission)
+
+	nodeResources := TestAccClassificationNodeResources(projectName, "Sprint 1", "2020-01-17")
+	return fmt.Sprintf("%s\n%s", nodeResources, permissionsResources)
+}